@@ -7,6 +7,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,6 +20,15 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ApiClient interface {
 	TranslateText(ctx context.Context, in *TranslateTextRequest, opts ...grpc.CallOption) (*TranslateTextResponse, error)
+	// TranslateTextStream is served over HTTP by a dedicated NDJSON handler
+	// (see pkg/server), since grpc-gateway does not support streaming calls
+	// with its in-process transport.
+	TranslateTextStream(ctx context.Context, in *TranslateTextRequest, opts ...grpc.CallOption) (Api_TranslateTextStreamClient, error)
+	TranslateTexts(ctx context.Context, in *TranslateTextsRequest, opts ...grpc.CallOption) (*TranslateTextsResponse, error)
+	DetectLanguage(ctx context.Context, in *DetectLanguageRequest, opts ...grpc.CallOption) (*DetectLanguageResponse, error)
+	SubmitTranslationJob(ctx context.Context, in *SubmitTranslationJobRequest, opts ...grpc.CallOption) (*SubmitTranslationJobResponse, error)
+	GetTranslationJob(ctx context.Context, in *GetTranslationJobRequest, opts ...grpc.CallOption) (*GetTranslationJobResponse, error)
+	ListLanguagePairs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListLanguagePairsResponse, error)
 }
 
 type apiClient struct {
@@ -38,11 +48,97 @@ func (c *apiClient) TranslateText(ctx context.Context, in *TranslateTextRequest,
 	return out, nil
 }
 
+func (c *apiClient) TranslateTextStream(ctx context.Context, in *TranslateTextRequest, opts ...grpc.CallOption) (Api_TranslateTextStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Api_ServiceDesc.Streams[0], "/api.Api/TranslateTextStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiTranslateTextStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Api_TranslateTextStreamClient interface {
+	Recv() (*TranslateTextStreamResponse, error)
+	grpc.ClientStream
+}
+
+type apiTranslateTextStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiTranslateTextStreamClient) Recv() (*TranslateTextStreamResponse, error) {
+	m := new(TranslateTextStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiClient) TranslateTexts(ctx context.Context, in *TranslateTextsRequest, opts ...grpc.CallOption) (*TranslateTextsResponse, error) {
+	out := new(TranslateTextsResponse)
+	err := c.cc.Invoke(ctx, "/api.Api/TranslateTexts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) DetectLanguage(ctx context.Context, in *DetectLanguageRequest, opts ...grpc.CallOption) (*DetectLanguageResponse, error) {
+	out := new(DetectLanguageResponse)
+	err := c.cc.Invoke(ctx, "/api.Api/DetectLanguage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) SubmitTranslationJob(ctx context.Context, in *SubmitTranslationJobRequest, opts ...grpc.CallOption) (*SubmitTranslationJobResponse, error) {
+	out := new(SubmitTranslationJobResponse)
+	err := c.cc.Invoke(ctx, "/api.Api/SubmitTranslationJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) GetTranslationJob(ctx context.Context, in *GetTranslationJobRequest, opts ...grpc.CallOption) (*GetTranslationJobResponse, error) {
+	out := new(GetTranslationJobResponse)
+	err := c.cc.Invoke(ctx, "/api.Api/GetTranslationJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ListLanguagePairs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListLanguagePairsResponse, error) {
+	out := new(ListLanguagePairsResponse)
+	err := c.cc.Invoke(ctx, "/api.Api/ListLanguagePairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiServer is the server API for Api service.
 // All implementations must embed UnimplementedApiServer
 // for forward compatibility
 type ApiServer interface {
 	TranslateText(context.Context, *TranslateTextRequest) (*TranslateTextResponse, error)
+	// TranslateTextStream is served over HTTP by a dedicated NDJSON handler
+	// (see pkg/server), since grpc-gateway does not support streaming calls
+	// with its in-process transport.
+	TranslateTextStream(*TranslateTextRequest, Api_TranslateTextStreamServer) error
+	TranslateTexts(context.Context, *TranslateTextsRequest) (*TranslateTextsResponse, error)
+	DetectLanguage(context.Context, *DetectLanguageRequest) (*DetectLanguageResponse, error)
+	SubmitTranslationJob(context.Context, *SubmitTranslationJobRequest) (*SubmitTranslationJobResponse, error)
+	GetTranslationJob(context.Context, *GetTranslationJobRequest) (*GetTranslationJobResponse, error)
+	ListLanguagePairs(context.Context, *emptypb.Empty) (*ListLanguagePairsResponse, error)
 	mustEmbedUnimplementedApiServer()
 }
 
@@ -53,6 +149,24 @@ type UnimplementedApiServer struct {
 func (UnimplementedApiServer) TranslateText(context.Context, *TranslateTextRequest) (*TranslateTextResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TranslateText not implemented")
 }
+func (UnimplementedApiServer) TranslateTextStream(*TranslateTextRequest, Api_TranslateTextStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method TranslateTextStream not implemented")
+}
+func (UnimplementedApiServer) TranslateTexts(context.Context, *TranslateTextsRequest) (*TranslateTextsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TranslateTexts not implemented")
+}
+func (UnimplementedApiServer) DetectLanguage(context.Context, *DetectLanguageRequest) (*DetectLanguageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectLanguage not implemented")
+}
+func (UnimplementedApiServer) SubmitTranslationJob(context.Context, *SubmitTranslationJobRequest) (*SubmitTranslationJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTranslationJob not implemented")
+}
+func (UnimplementedApiServer) GetTranslationJob(context.Context, *GetTranslationJobRequest) (*GetTranslationJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTranslationJob not implemented")
+}
+func (UnimplementedApiServer) ListLanguagePairs(context.Context, *emptypb.Empty) (*ListLanguagePairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLanguagePairs not implemented")
+}
 func (UnimplementedApiServer) mustEmbedUnimplementedApiServer() {}
 
 // UnsafeApiServer may be embedded to opt out of forward compatibility for this service.
@@ -84,6 +198,117 @@ func _Api_TranslateText_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Api_TranslateTextStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TranslateTextRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServer).TranslateTextStream(m, &apiTranslateTextStreamServer{stream})
+}
+
+type Api_TranslateTextStreamServer interface {
+	Send(*TranslateTextStreamResponse) error
+	grpc.ServerStream
+}
+
+type apiTranslateTextStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiTranslateTextStreamServer) Send(m *TranslateTextStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Api_TranslateTexts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TranslateTextsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).TranslateTexts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Api/TranslateTexts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).TranslateTexts(ctx, req.(*TranslateTextsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_DetectLanguage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectLanguageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).DetectLanguage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Api/DetectLanguage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).DetectLanguage(ctx, req.(*DetectLanguageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_SubmitTranslationJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTranslationJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).SubmitTranslationJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Api/SubmitTranslationJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).SubmitTranslationJob(ctx, req.(*SubmitTranslationJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_GetTranslationJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTranslationJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).GetTranslationJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Api/GetTranslationJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).GetTranslationJob(ctx, req.(*GetTranslationJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ListLanguagePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ListLanguagePairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Api/ListLanguagePairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ListLanguagePairs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Api_ServiceDesc is the grpc.ServiceDesc for Api service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -95,6 +320,298 @@ var Api_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TranslateText",
 			Handler:    _Api_TranslateText_Handler,
 		},
+		{
+			MethodName: "TranslateTexts",
+			Handler:    _Api_TranslateTexts_Handler,
+		},
+		{
+			MethodName: "DetectLanguage",
+			Handler:    _Api_DetectLanguage_Handler,
+		},
+		{
+			MethodName: "SubmitTranslationJob",
+			Handler:    _Api_SubmitTranslationJob_Handler,
+		},
+		{
+			MethodName: "GetTranslationJob",
+			Handler:    _Api_GetTranslationJob_Handler,
+		},
+		{
+			MethodName: "ListLanguagePairs",
+			Handler:    _Api_ListLanguagePairs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TranslateTextStream",
+			Handler:       _Api_TranslateTextStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api.proto",
+}
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	LoadModel(ctx context.Context, in *LoadModelRequest, opts ...grpc.CallOption) (*ModelStatusResponse, error)
+	UnloadModel(ctx context.Context, in *UnloadModelRequest, opts ...grpc.CallOption) (*ModelStatusResponse, error)
+	GetModelStatus(ctx context.Context, in *GetModelStatusRequest, opts ...grpc.CallOption) (*ModelStatusResponse, error)
+	ListResidentModels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListResidentModelsResponse, error)
+	ReloadConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReloadConfigurationResponse, error)
+	UploadGlossary(ctx context.Context, in *UploadGlossaryRequest, opts ...grpc.CallOption) (*UploadGlossaryResponse, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) LoadModel(ctx context.Context, in *LoadModelRequest, opts ...grpc.CallOption) (*ModelStatusResponse, error) {
+	out := new(ModelStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.Admin/LoadModel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UnloadModel(ctx context.Context, in *UnloadModelRequest, opts ...grpc.CallOption) (*ModelStatusResponse, error) {
+	out := new(ModelStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.Admin/UnloadModel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetModelStatus(ctx context.Context, in *GetModelStatusRequest, opts ...grpc.CallOption) (*ModelStatusResponse, error) {
+	out := new(ModelStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.Admin/GetModelStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListResidentModels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListResidentModelsResponse, error) {
+	out := new(ListResidentModelsResponse)
+	err := c.cc.Invoke(ctx, "/api.Admin/ListResidentModels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ReloadConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReloadConfigurationResponse, error) {
+	out := new(ReloadConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/api.Admin/ReloadConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UploadGlossary(ctx context.Context, in *UploadGlossaryRequest, opts ...grpc.CallOption) (*UploadGlossaryResponse, error) {
+	out := new(UploadGlossaryResponse)
+	err := c.cc.Invoke(ctx, "/api.Admin/UploadGlossary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	LoadModel(context.Context, *LoadModelRequest) (*ModelStatusResponse, error)
+	UnloadModel(context.Context, *UnloadModelRequest) (*ModelStatusResponse, error)
+	GetModelStatus(context.Context, *GetModelStatusRequest) (*ModelStatusResponse, error)
+	ListResidentModels(context.Context, *emptypb.Empty) (*ListResidentModelsResponse, error)
+	ReloadConfiguration(context.Context, *emptypb.Empty) (*ReloadConfigurationResponse, error)
+	UploadGlossary(context.Context, *UploadGlossaryRequest) (*UploadGlossaryResponse, error)
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) LoadModel(context.Context, *LoadModelRequest) (*ModelStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadModel not implemented")
+}
+func (UnimplementedAdminServer) UnloadModel(context.Context, *UnloadModelRequest) (*ModelStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnloadModel not implemented")
+}
+func (UnimplementedAdminServer) GetModelStatus(context.Context, *GetModelStatusRequest) (*ModelStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModelStatus not implemented")
+}
+func (UnimplementedAdminServer) ListResidentModels(context.Context, *emptypb.Empty) (*ListResidentModelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResidentModels not implemented")
+}
+func (UnimplementedAdminServer) ReloadConfiguration(context.Context, *emptypb.Empty) (*ReloadConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfiguration not implemented")
+}
+func (UnimplementedAdminServer) UploadGlossary(context.Context, *UploadGlossaryRequest) (*UploadGlossaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadGlossary not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_LoadModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).LoadModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Admin/LoadModel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).LoadModel(ctx, req.(*LoadModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UnloadModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnloadModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UnloadModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Admin/UnloadModel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UnloadModel(ctx, req.(*UnloadModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetModelStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModelStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetModelStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Admin/GetModelStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetModelStatus(ctx, req.(*GetModelStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListResidentModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListResidentModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Admin/ListResidentModels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListResidentModels(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ReloadConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ReloadConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Admin/ReloadConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ReloadConfiguration(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UploadGlossary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadGlossaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UploadGlossary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Admin/UploadGlossary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UploadGlossary(ctx, req.(*UploadGlossaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LoadModel",
+			Handler:    _Admin_LoadModel_Handler,
+		},
+		{
+			MethodName: "UnloadModel",
+			Handler:    _Admin_UnloadModel_Handler,
+		},
+		{
+			MethodName: "GetModelStatus",
+			Handler:    _Admin_GetModelStatus_Handler,
+		},
+		{
+			MethodName: "ListResidentModels",
+			Handler:    _Admin_ListResidentModels_Handler,
+		},
+		{
+			MethodName: "ReloadConfiguration",
+			Handler:    _Admin_ReloadConfiguration_Handler,
+		},
+		{
+			MethodName: "UploadGlossary",
+			Handler:    _Admin_UploadGlossary_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
@@ -16,14 +16,8 @@
 
 set -ex
 
-# Generate api.proto
-gnostic \
-  --grpc-out=. \
-  api.yaml
-
-sed \
-  -i "2i option go_package = \"github.com/SpecializedGeneralist/translator/pkg/api\";" \
-  api.proto
+# api.proto is maintained by hand, since it defines streaming calls which
+# cannot be described with OpenAPI. Please keep it aligned with api.yaml.
 
 # Generate api.pb.go
 protoc \
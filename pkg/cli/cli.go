@@ -15,13 +15,17 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"github.com/SpecializedGeneralist/translator/pkg/configuration"
 	"github.com/SpecializedGeneralist/translator/pkg/models"
 	"github.com/SpecializedGeneralist/translator/pkg/server"
+	"github.com/SpecializedGeneralist/translator/pkg/translationmemory"
 	"github.com/rs/zerolog"
 	"github.com/urfave/cli/v2"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 )
 
@@ -32,6 +36,7 @@ func Run(arguments []string) {
 		Usage:     "Translation service",
 		Flags:     flags,
 		Action:    runAction,
+		Commands:  []*cli.Command{memoryCommand},
 		Reader:    os.Stdin,
 		Writer:    os.Stdout,
 		ErrWriter: os.Stderr,
@@ -67,13 +72,48 @@ func runAction(ctx *cli.Context) (err error) {
 	}()
 
 	manager := models.NewManager(config, logger)
+
+	if config.TranslationMemory.Path != "" {
+		memory, err := translationmemory.Open(config.TranslationMemory.Path, logger)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if closeErr := memory.Close(); closeErr != nil {
+				logger.Err(closeErr).Msg("error closing translation memory")
+			}
+		}()
+		manager.SetTranslationMemory(memory)
+	}
+
 	err = manager.LoadModels()
 	if err != nil {
 		return err
 	}
 
+	defer func() {
+		if unloadErr := manager.UnloadModels(); unloadErr != nil && err == nil {
+			err = unloadErr
+		}
+	}()
+
+	configFile := ctx.String("config")
+	reload := func() error {
+		newConfig, err := configuration.FromYAMLFile(configFile)
+		if err != nil {
+			return err
+		}
+		return manager.Reload(newConfig)
+	}
+
+	runCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	reloadOnSIGHUP(runCtx, reload, logger)
+
 	srv := server.New(config, manager, logger)
-	return srv.Run()
+	srv.SetConfigurationReloader(reload)
+	return srv.Run(runCtx)
 }
 
 func newLogger(level zerolog.Level) zerolog.Logger {
@@ -0,0 +1,124 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/SpecializedGeneralist/translator/pkg/translationmemory"
+	"github.com/rs/zerolog"
+	"github.com/urfave/cli/v2"
+	"io"
+	"os"
+)
+
+var memoryCommand = &cli.Command{
+	Name:  "memory",
+	Usage: "Manage the translation memory",
+	Description: "The translation memory cannot be accessed while it is in use " +
+		"by a running server: stop the server first, or work on a copy.",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "export",
+			Usage:  "Export the translation memory as newline-delimited JSON",
+			Flags:  []cli.Flag{fileFlag("write to `FILE` instead of standard output")},
+			Action: memoryExportAction,
+		},
+		{
+			Name:   "import",
+			Usage:  "Import newline-delimited JSON translations into the translation memory",
+			Flags:  []cli.Flag{fileFlag("read from `FILE` instead of standard input")},
+			Action: memoryImportAction,
+		},
+	},
+}
+
+func fileFlag(usage string) cli.Flag {
+	return &cli.StringFlag{
+		Name:    "file",
+		Aliases: []string{"f"},
+		Usage:   usage,
+	}
+}
+
+func memoryExportAction(ctx *cli.Context) error {
+	return withMemory(ctx, func(memory *translationmemory.Memory, logger zerolog.Logger) error {
+		var w io.Writer = ctx.App.Writer
+		if filename := ctx.String("file"); filename != "" {
+			f, err := os.Create(filename)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if closeErr := f.Close(); closeErr != nil {
+					logger.Err(closeErr).Send()
+				}
+			}()
+			w = f
+		}
+
+		count, err := memory.Export(w)
+		if err != nil {
+			return err
+		}
+		logger.Info().Int("count", count).Msg("translation memory exported")
+		return nil
+	})
+}
+
+func memoryImportAction(ctx *cli.Context) error {
+	return withMemory(ctx, func(memory *translationmemory.Memory, logger zerolog.Logger) error {
+		var r io.Reader = ctx.App.Reader
+		if filename := ctx.String("file"); filename != "" {
+			f, err := os.Open(filename)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = f.Close() }()
+			r = f
+		}
+
+		count, err := memory.Import(r)
+		if err != nil {
+			return err
+		}
+		logger.Info().Int("count", count).Msg("translation memory imported")
+		return nil
+	})
+}
+
+// withMemory opens the translation memory from the configuration, calls
+// f, and finally closes the memory.
+func withMemory(ctx *cli.Context, f func(*translationmemory.Memory, zerolog.Logger) error) error {
+	config, err := configuration.FromYAMLFile(ctx.String("config"))
+	if err != nil {
+		return err
+	}
+	if config.TranslationMemory.Path == "" {
+		return fmt.Errorf("the translation memory is not configured")
+	}
+
+	logger := newLogger(zerolog.Level(config.LogLevel))
+
+	memory, err := translationmemory.Open(config.TranslationMemory.Path, logger)
+	if err != nil {
+		return err
+	}
+	err = f(memory, logger)
+	if closeErr := memory.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	return err
+}
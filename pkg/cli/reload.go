@@ -0,0 +1,46 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"github.com/rs/zerolog"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// reloadOnSIGHUP calls reload in the background whenever the process
+// receives a SIGHUP signal, until ctx is done. Signals received while a
+// reload is in progress trigger one more reload afterwards.
+func reloadOnSIGHUP(ctx context.Context, reload func() error, logger zerolog.Logger) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(ch)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ch:
+				logger.Info().Msg("SIGHUP received")
+				if err := reload(); err != nil {
+					logger.Err(err).Msg("configuration reload failed")
+				}
+			}
+		}
+	}()
+}
@@ -15,11 +15,30 @@
 package configuration
 
 import (
+	"bufio"
 	"bytes"
 	"fmt"
 	"github.com/rs/zerolog"
 	"gopkg.in/yaml.v3"
 	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultShutdownTimeout is the ShutdownTimeout set by FromYAMLFile
+	// when the configuration does not provide it.
+	DefaultShutdownTimeout = 30 * time.Second
+	// DefaultMaxBatchInputs is the MaxBatchInputs set by FromYAMLFile when
+	// the configuration does not provide it.
+	DefaultMaxBatchInputs = 100
+	// DefaultMaxTranslationJobs is the TranslationJobs.MaxJobs set by
+	// FromYAMLFile when the configuration does not provide it.
+	DefaultMaxTranslationJobs = 10000
+	// DefaultTranslationJobsRetention is the TranslationJobs.Retention set
+	// by FromYAMLFile when the configuration does not provide it.
+	DefaultTranslationJobsRetention = time.Hour
 )
 
 // Config is the main translator server configuration.
@@ -33,12 +52,50 @@ type Config struct {
 	// MaxConcurrentComputations is the maximum amount of concurrent
 	// computations allowed.
 	MaxConcurrentComputations int `yaml:"max_concurrent_computations"`
+	// MaxPendingJobs is the maximum amount of jobs which can wait for
+	// processing before the server is reported as not ready.
+	// Zero disables the check.
+	MaxPendingJobs int `yaml:"max_pending_jobs"`
+	// MaxBatchInputs is the maximum amount of inputs of a single
+	// TranslateTexts request. Zero means DefaultMaxBatchInputs.
+	MaxBatchInputs int `yaml:"max_batch_inputs"`
+	// ShutdownTimeout is the maximum amount of time to wait for in-progress
+	// requests to complete when the server is shutting down. Zero means
+	// DefaultShutdownTimeout.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
 	// TLSEnabled reports whether to enable TLS.
 	TLSEnabled bool `yaml:"tls_enabled"`
 	// TLSCert is the TLS cert file. It is ignored if TLSEnabled is false.
 	TLSCert string `yaml:"tls_cert"`
 	// TLSKey is the TLS key file. It is ignored if TLSEnabled is false.
 	TLSKey string `yaml:"tls_key"`
+	// GenerationLimits provides server-side limits for the generation
+	// parameters which can be set by clients requests.
+	GenerationLimits GenerationLimits `yaml:"generation_limits"`
+	// Cache configures the in-memory translation cache.
+	Cache Cache `yaml:"cache"`
+	// TranslationMemory configures the persistent translation memory.
+	TranslationMemory TranslationMemory `yaml:"translation_memory"`
+	// PivotTranslation configures the translation through intermediate
+	// languages.
+	PivotTranslation PivotTranslation `yaml:"pivot_translation"`
+	// Glossaries provide the mandated translations of terms for language
+	// pairs.
+	Glossaries []Glossary `yaml:"glossaries"`
+	// Fallbacks configure what to do when the translation for a language
+	// pair fails.
+	Fallbacks []Fallback `yaml:"fallbacks"`
+	// EntityProtection configures the automatic protection of entities,
+	// such as URLs, from being translated.
+	EntityProtection EntityProtection `yaml:"entity_protection"`
+	// TranslationJobs configures the asynchronous translation jobs.
+	TranslationJobs TranslationJobs `yaml:"translation_jobs"`
+	// LazyLoading configures the loading of models on first use.
+	LazyLoading LazyLoading `yaml:"lazy_loading"`
+	// Batching configures the micro-batching of concurrent translations.
+	Batching Batching `yaml:"batching"`
+	// Admin configures the administrative service.
+	Admin Admin `yaml:"admin"`
 	// ModelsPath is the local path for all spaGO-compatible models.
 	ModelsPath string `yaml:"models_path"`
 	// LanguageModels provides the configuration for translation models
@@ -53,8 +110,324 @@ type LanguageModel struct {
 	Source string `yaml:"source"`
 	// Target is an identifier for the target language of translation.
 	Target string `yaml:"target"`
+	// SourceAliases are alternative identifiers of the source language,
+	// accepted from clients requests.
+	SourceAliases []string `yaml:"source_aliases"`
+	// TargetAliases are alternative identifiers of the target language,
+	// accepted from clients requests.
+	TargetAliases []string `yaml:"target_aliases"`
 	// Model is the name of a spaGO-compatible model.
 	Model string `yaml:"model"`
+	// Weight is the share of the translations of the language pair served
+	// by the model, relative to the other models configured for the same
+	// pair. Models with zero weight only serve requests asking for them
+	// explicitly, unless no model of the pair has a positive weight, in
+	// which case the first one serves all the translations.
+	Weight int `yaml:"weight"`
+	// Version optionally identifies the version of the model. Translations
+	// stored in the translation memory are only reused for the same model
+	// name and version.
+	Version string `yaml:"version"`
+	// Generation optionally overrides the default generation parameters
+	// of the model.
+	Generation GenerationParams `yaml:"generation"`
+}
+
+// GenerationParams provides parameters for text generation (decoding).
+//
+// Zero values stand for unset parameters, which are taken from other
+// sources: the parameters from clients requests override the ones from the
+// configuration of the language model, which in turn override the defaults
+// from the configuration of the spaGO model.
+type GenerationParams struct {
+	// NumBeams is the number of beams for beam search.
+	NumBeams int `yaml:"num_beams"`
+	// MaxLength is the maximum length of the generated sequence, in tokens.
+	MaxLength int `yaml:"max_length"`
+	// LengthPenalty is the exponential penalty to the length of the
+	// sequences: values < 1.0 encourage shorter sequences, values > 1.0
+	// encourage longer ones. The default is 1.0.
+	LengthPenalty float64 `yaml:"length_penalty"`
+	// NoRepeatNGramSize, if greater than zero, prevents any n-gram of this
+	// size from being generated more than once.
+	NoRepeatNGramSize int `yaml:"no_repeat_ngram_size"`
+	// Temperature, if greater than zero, enables sampling (instead of beam
+	// search) and is used to modulate the next token probabilities.
+	Temperature float64 `yaml:"temperature"`
+	// TopK, if greater than zero, restricts sampling to the K most likely
+	// next tokens.
+	TopK int `yaml:"top_k"`
+	// TopP, if greater than zero, restricts sampling to the smallest set of
+	// most likely next tokens whose cumulative probability exceeds TopP
+	// (nucleus sampling).
+	TopP float64 `yaml:"top_p"`
+}
+
+// Override returns a copy of p where the non-zero parameters from other
+// replace the corresponding ones.
+func (p GenerationParams) Override(other GenerationParams) GenerationParams {
+	if other.NumBeams != 0 {
+		p.NumBeams = other.NumBeams
+	}
+	if other.MaxLength != 0 {
+		p.MaxLength = other.MaxLength
+	}
+	if other.LengthPenalty != 0 {
+		p.LengthPenalty = other.LengthPenalty
+	}
+	if other.NoRepeatNGramSize != 0 {
+		p.NoRepeatNGramSize = other.NoRepeatNGramSize
+	}
+	if other.Temperature != 0 {
+		p.Temperature = other.Temperature
+	}
+	if other.TopK != 0 {
+		p.TopK = other.TopK
+	}
+	if other.TopP != 0 {
+		p.TopP = other.TopP
+	}
+	return p
+}
+
+// Validate returns an error if any parameter is out of its valid range.
+func (p GenerationParams) Validate() error {
+	switch {
+	case p.NumBeams < 0:
+		return fmt.Errorf("invalid num_beams %d: it must not be negative", p.NumBeams)
+	case p.MaxLength < 0:
+		return fmt.Errorf("invalid max_length %d: it must not be negative", p.MaxLength)
+	case p.LengthPenalty < 0:
+		return fmt.Errorf("invalid length_penalty %g: it must not be negative", p.LengthPenalty)
+	case p.NoRepeatNGramSize < 0:
+		return fmt.Errorf("invalid no_repeat_ngram_size %d: it must not be negative", p.NoRepeatNGramSize)
+	case p.Temperature < 0:
+		return fmt.Errorf("invalid temperature %g: it must not be negative", p.Temperature)
+	case p.TopK < 0:
+		return fmt.Errorf("invalid top_k %d: it must not be negative", p.TopK)
+	case p.TopP < 0 || p.TopP > 1:
+		return fmt.Errorf("invalid top_p %g: it must be in range [0, 1]", p.TopP)
+	}
+	return nil
+}
+
+// GenerationLimits provides server-side limits for the generation parameters
+// set by clients requests. Zero values stand for no limit.
+type GenerationLimits struct {
+	// MaxNumBeams is the maximum allowed value for GenerationParams.NumBeams.
+	MaxNumBeams int `yaml:"max_num_beams"`
+	// MaxLength is the maximum allowed value for GenerationParams.MaxLength.
+	MaxLength int `yaml:"max_length"`
+}
+
+// Check returns an error if the given parameters exceed the limits.
+func (l GenerationLimits) Check(p GenerationParams) error {
+	if l.MaxNumBeams > 0 && p.NumBeams > l.MaxNumBeams {
+		return fmt.Errorf("num_beams %d exceeds the limit of %d", p.NumBeams, l.MaxNumBeams)
+	}
+	if l.MaxLength > 0 && p.MaxLength > l.MaxLength {
+		return fmt.Errorf("max_length %d exceeds the limit of %d", p.MaxLength, l.MaxLength)
+	}
+	return nil
+}
+
+// Cache provides the configuration of the in-memory translation cache.
+type Cache struct {
+	// MaxEntries is the maximum amount of cached translations.
+	// Zero disables the cache.
+	MaxEntries int `yaml:"max_entries"`
+	// TTL is how long a cached translation remains valid.
+	// Zero means that cached translations never expire.
+	TTL time.Duration `yaml:"ttl"`
+}
+
+// TranslationMemory provides the configuration of the persistent
+// translation memory.
+type TranslationMemory struct {
+	// Path is the directory where the translation memory database is
+	// stored. An empty path disables the translation memory.
+	Path string `yaml:"path"`
+}
+
+// PivotTranslation provides the configuration of the translation through
+// intermediate languages, for language pairs without a dedicated model.
+type PivotTranslation struct {
+	// Enabled reports whether pivot translation is enabled.
+	Enabled bool `yaml:"enabled"`
+	// MaxIntermediateLanguages is the maximum amount of intermediate
+	// languages of a translation. Zero means no limit.
+	MaxIntermediateLanguages int `yaml:"max_intermediate_languages"`
+}
+
+// TranslationJobs provides the configuration of the asynchronous
+// translation jobs.
+type TranslationJobs struct {
+	// MaxJobs is the maximum amount of jobs kept by the server. Once the
+	// limit is reached, the oldest finished jobs are discarded to make room
+	// for new ones. Zero means DefaultMaxTranslationJobs.
+	MaxJobs int `yaml:"max_jobs"`
+	// Retention is how long finished jobs are kept. Zero means
+	// DefaultTranslationJobsRetention.
+	Retention time.Duration `yaml:"retention"`
+	// WebhookURL is an optional URL which is notified with a POST request
+	// whenever a job is completed or failed.
+	WebhookURL string `yaml:"webhook_url"`
+	// WebhookTimeout is the timeout of each webhook request. Zero means
+	// a default of 10 seconds.
+	WebhookTimeout time.Duration `yaml:"webhook_timeout"`
+}
+
+// LazyLoading provides the configuration of the loading of models on
+// demand, instead of at startup.
+type LazyLoading struct {
+	// Enabled reports whether models are loaded on first use.
+	Enabled bool `yaml:"enabled"`
+	// MaxResidentModels is the maximum amount of models kept loaded at the
+	// same time. Zero means no limit.
+	MaxResidentModels int `yaml:"max_resident_models"`
+	// MemoryBudgetMB is the maximum memory, in MiB, used by the models kept
+	// loaded at the same time, estimated from the size of their files.
+	// Zero means no limit.
+	MemoryBudgetMB int `yaml:"memory_budget_mb"`
+}
+
+// Batching provides the configuration of the dynamic micro-batching of
+// concurrent translations: the requests for the same model arriving within
+// a short time window are computed together, sharing the forward passes of
+// the encoder and the decoder.
+type Batching struct {
+	// Window is how long the first request of a batch waits for more
+	// requests to join it. Zero disables batching.
+	Window time.Duration `yaml:"window"`
+	// MaxBatchSize is the maximum amount of requests in a batch. A full
+	// batch is computed without waiting for the window to expire. Zero
+	// means no limit.
+	MaxBatchSize int `yaml:"max_batch_size"`
+	// MaxBatchTokens is the maximum sum of the input tokens of the requests
+	// in a batch. Zero means no limit.
+	MaxBatchTokens int `yaml:"max_batch_tokens"`
+}
+
+// Admin provides the configuration of the administrative service, which
+// allows to load, unload and inspect the models at runtime.
+type Admin struct {
+	// Token is the secret token which clients must provide to call the
+	// admin service, as bearer token of the "authorization" header.
+	// An empty token disables the admin service.
+	Token string `yaml:"token"`
+}
+
+// EntityProtection provides the configuration of the automatic protection
+// of entities which must not be translated.
+type EntityProtection struct {
+	// Enabled reports whether entity protection is enabled.
+	Enabled bool `yaml:"enabled"`
+	// Entities are the kinds of entities to protect: "url", "email",
+	// "hashtag", "mention", "number" (numbers with units or currencies)
+	// and "code" (code identifiers and file names). An empty list means
+	// all of them.
+	Entities []string `yaml:"entities"`
+}
+
+// Fallback provides the configuration of the fallbacks of a language pair,
+// which are tried in order when a translation fails, panics or exceeds the
+// timeout.
+type Fallback struct {
+	// Source is the source language of the pair.
+	Source string `yaml:"source"`
+	// Target is the target language of the pair.
+	Target string `yaml:"target"`
+	// Timeout is the deadline of each translation attempt. Zero means no
+	// deadline.
+	Timeout time.Duration `yaml:"timeout"`
+	// Models are the names of alternate models, among the ones configured
+	// for the same language pair, to try first.
+	Models []string `yaml:"models"`
+	// Pivot reports whether to try the translation through intermediate
+	// languages, after the alternate models.
+	Pivot bool `yaml:"pivot"`
+	// Untranslated reports whether to return the source text untranslated,
+	// if everything else fails.
+	Untranslated bool `yaml:"untranslated"`
+}
+
+// Glossary provides the mandated translations of terms, such as brand
+// names and domain-specific terminology, for a language pair.
+type Glossary struct {
+	// Source is an identifier for the source language of translation.
+	Source string `yaml:"source"`
+	// Target is an identifier for the target language of translation.
+	Target string `yaml:"target"`
+	// Path is an optional tab-separated file of terms (see
+	// ReadGlossaryFile), in addition to Terms.
+	Path string `yaml:"path"`
+	// Terms are the glossary terms.
+	Terms []GlossaryTerm `yaml:"terms"`
+}
+
+// GlossaryTerm is a term in the source language, together with its
+// mandated translation.
+type GlossaryTerm struct {
+	// Source is the term in the source language.
+	Source string `yaml:"source"`
+	// Target is the translation of the term in the target language.
+	Target string `yaml:"target"`
+	// CaseSensitive reports whether the source term is matched
+	// case-sensitively.
+	CaseSensitive bool `yaml:"case_sensitive"`
+}
+
+// AllTerms returns the terms of the glossary, including the ones read
+// from its file, if any.
+func (g Glossary) AllTerms() ([]GlossaryTerm, error) {
+	if g.Path == "" {
+		return g.Terms, nil
+	}
+	fileTerms, err := ReadGlossaryFile(g.Path)
+	if err != nil {
+		return nil, err
+	}
+	terms := make([]GlossaryTerm, 0, len(g.Terms)+len(fileTerms))
+	terms = append(terms, g.Terms...)
+	return append(terms, fileTerms...), nil
+}
+
+// ReadGlossaryFile reads glossary terms from a tab-separated file.
+//
+// Each line holds a source term and its target translation, optionally
+// followed by a boolean value reporting whether the source term is
+// case-sensitive. Empty lines and lines starting with "#" are ignored.
+func ReadGlossaryFile(filename string) ([]GlossaryTerm, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading glossary file %#v: %w", filename, err)
+	}
+	defer f.Close()
+
+	var terms []GlossaryTerm
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, fmt.Errorf("glossary file %#v, line %d: expected 2 or 3 tab-separated fields, got %d", filename, lineNum, len(fields))
+		}
+		term := GlossaryTerm{Source: fields[0], Target: fields[1]}
+		if len(fields) == 3 {
+			term.CaseSensitive, err = strconv.ParseBool(strings.TrimSpace(fields[2]))
+			if err != nil {
+				return nil, fmt.Errorf("glossary file %#v, line %d: invalid case sensitivity %#v", filename, lineNum, fields[2])
+			}
+		}
+		terms = append(terms, term)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading glossary file %#v: %w", filename, err)
+	}
+	return terms, nil
 }
 
 // LogLevel is a redefinition of zerolog.Level which satisfies
@@ -80,5 +453,60 @@ func FromYAMLFile(filename string) (*Config, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error decoding configuration YAML file %#v: %w", filename, err)
 	}
+	if config.ShutdownTimeout < 0 {
+		return nil, fmt.Errorf("invalid shutdown_timeout %s: it must not be negative", config.ShutdownTimeout)
+	}
+	if config.ShutdownTimeout == 0 {
+		config.ShutdownTimeout = DefaultShutdownTimeout
+	}
+	if config.MaxBatchInputs < 0 {
+		return nil, fmt.Errorf("invalid max_batch_inputs %d: it must not be negative", config.MaxBatchInputs)
+	}
+	if config.MaxBatchInputs == 0 {
+		config.MaxBatchInputs = DefaultMaxBatchInputs
+	}
+	if config.Cache.MaxEntries < 0 {
+		return nil, fmt.Errorf("invalid cache max_entries %d: it must not be negative", config.Cache.MaxEntries)
+	}
+	if config.TranslationJobs.MaxJobs < 0 {
+		return nil, fmt.Errorf("invalid translation_jobs max_jobs %d: it must not be negative", config.TranslationJobs.MaxJobs)
+	}
+	if config.TranslationJobs.MaxJobs == 0 {
+		config.TranslationJobs.MaxJobs = DefaultMaxTranslationJobs
+	}
+	if config.TranslationJobs.Retention < 0 {
+		return nil, fmt.Errorf("invalid translation_jobs retention %s: it must not be negative", config.TranslationJobs.Retention)
+	}
+	if config.TranslationJobs.Retention == 0 {
+		config.TranslationJobs.Retention = DefaultTranslationJobsRetention
+	}
+	if config.LazyLoading.MaxResidentModels < 0 {
+		return nil, fmt.Errorf("invalid lazy_loading max_resident_models %d: it must not be negative", config.LazyLoading.MaxResidentModels)
+	}
+	if config.LazyLoading.MemoryBudgetMB < 0 {
+		return nil, fmt.Errorf("invalid lazy_loading memory_budget_mb %d: it must not be negative", config.LazyLoading.MemoryBudgetMB)
+	}
+	if config.Batching.Window < 0 {
+		return nil, fmt.Errorf("invalid batching window %s: it must not be negative", config.Batching.Window)
+	}
+	if config.Batching.MaxBatchSize < 0 {
+		return nil, fmt.Errorf("invalid batching max_batch_size %d: it must not be negative", config.Batching.MaxBatchSize)
+	}
+	if config.Batching.MaxBatchTokens < 0 {
+		return nil, fmt.Errorf("invalid batching max_batch_tokens %d: it must not be negative", config.Batching.MaxBatchTokens)
+	}
+	for _, f := range config.Fallbacks {
+		if f.Timeout < 0 {
+			return nil, fmt.Errorf("invalid fallback timeout %s for translation from %#v to %#v: it must not be negative", f.Timeout, f.Source, f.Target)
+		}
+	}
+	for _, lm := range config.LanguageModels {
+		if lm.Weight < 0 {
+			return nil, fmt.Errorf("invalid weight %d for language model %#v: it must not be negative", lm.Weight, lm.Model)
+		}
+		if err = lm.Generation.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid generation parameters for language model %#v: %w", lm.Model, err)
+		}
+	}
 	return config, nil
 }
@@ -0,0 +1,62 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package formats provides the translation of structured documents, such
+// as HTML or Markdown, where only the human-readable text must be translated, while
+// the structure is preserved.
+package formats
+
+import "fmt"
+
+// Format identifies the format of a text to translate.
+type Format string
+
+const (
+	// Text is the format of plain texts.
+	Text Format = "text"
+	// HTML is the format of HTML documents and fragments.
+	HTML Format = "html"
+	// Markdown is the format of Markdown documents.
+	Markdown Format = "markdown"
+)
+
+// ParseFormat parses a format name. The empty string stands for Text.
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(name); f {
+	case "":
+		return Text, nil
+	case Text, HTML, Markdown:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unsupported format %#v", name)
+	}
+}
+
+// Translator translates a plain text.
+type Translator func(text string) (string, error)
+
+// Translate translates a text of the given format, calling translate for
+// each portion of human-readable text.
+func Translate(format Format, text string, translate Translator) (string, error) {
+	switch format {
+	case Text:
+		return translate(text)
+	case HTML:
+		return TranslateHTML(text, translate)
+	case Markdown:
+		return TranslateMarkdown(text, translate)
+	default:
+		return "", fmt.Errorf("unsupported format %#v", format)
+	}
+}
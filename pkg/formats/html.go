@@ -0,0 +1,463 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"fmt"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"io"
+	"strings"
+)
+
+// translatableAttributes are the attributes whose values are translated.
+var translatableAttributes = map[string]struct{}{
+	"alt":         {},
+	"title":       {},
+	"placeholder": {},
+	"aria-label":  {},
+}
+
+// inlineElements are the elements which are translated together with the
+// surrounding text, keeping them anchored to the translated words.
+var inlineElements = map[atom.Atom]struct{}{
+	atom.A: {}, atom.Abbr: {}, atom.B: {}, atom.Bdi: {}, atom.Bdo: {},
+	atom.Cite: {}, atom.Data: {}, atom.Del: {}, atom.Dfn: {}, atom.Em: {},
+	atom.Font: {}, atom.I: {}, atom.Ins: {}, atom.Label: {}, atom.Mark: {},
+	atom.Q: {}, atom.S: {}, atom.Small: {}, atom.Span: {}, atom.Strong: {},
+	atom.Sub: {}, atom.Sup: {}, atom.Time: {}, atom.U: {},
+}
+
+// opaqueElements are the elements whose content is never translated.
+// Those which can appear within text (such as images and code) are kept
+// in place, as a whole.
+var opaqueElements = map[atom.Atom]struct{}{
+	atom.Br: {}, atom.Code: {}, atom.Img: {}, atom.Input: {}, atom.Kbd: {},
+	atom.Math: {}, atom.Noscript: {}, atom.Pre: {}, atom.Samp: {},
+	atom.Script: {}, atom.Select: {}, atom.Style: {}, atom.Svg: {},
+	atom.Template: {}, atom.Textarea: {}, atom.Var: {}, atom.Wbr: {},
+}
+
+// opaqueInlineElements are the opaque elements which can appear within
+// text.
+var opaqueInlineElements = map[atom.Atom]struct{}{
+	atom.Br: {}, atom.Code: {}, atom.Img: {}, atom.Input: {}, atom.Kbd: {},
+	atom.Samp: {}, atom.Var: {}, atom.Wbr: {},
+}
+
+// TranslateHTML translates an HTML document or fragment, calling translate
+// for each portion of human-readable text.
+//
+// Only text nodes and the values of a few attributes (alt, title,
+// placeholder and aria-label) are translated. Scripts, styles, code and any
+// element with a translate="no" attribute or a "notranslate" class are left
+// untouched.
+//
+// Consecutive text and inline elements (such as <b> or <a>) are translated
+// together, with simplified tags (without attributes), so that the
+// translation can move them around the translated words. If the
+// translation does not preserve the tags, each text node is translated on
+// its own instead.
+//
+// The result is well-formed HTML: being rendered from the parsed document,
+// it may differ from the source also in non-significant details, such as
+// quoting.
+func TranslateHTML(src string, translate Translator) (string, error) {
+	t := &htmlTranslator{translate: translate}
+
+	if isHTMLDocument(src) {
+		doc, err := html.Parse(strings.NewReader(src))
+		if err != nil {
+			return "", fmt.Errorf("error parsing HTML: %w", err)
+		}
+		if err = t.translateNode(doc); err != nil {
+			return "", err
+		}
+		var sb strings.Builder
+		if err = html.Render(&sb, doc); err != nil {
+			return "", err
+		}
+		return sb.String(), nil
+	}
+
+	body := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(src), body)
+	if err != nil {
+		return "", fmt.Errorf("error parsing HTML: %w", err)
+	}
+	for _, n := range nodes {
+		body.AppendChild(n)
+	}
+	if err = t.translateNode(body); err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for n := body.FirstChild; n != nil; n = n.NextSibling {
+		if err = html.Render(&sb, n); err != nil {
+			return "", err
+		}
+	}
+	return sb.String(), nil
+}
+
+func isHTMLDocument(src string) bool {
+	s := strings.ToLower(strings.TrimSpace(src))
+	return strings.HasPrefix(s, "<!doctype") || strings.HasPrefix(s, "<html")
+}
+
+type htmlTranslator struct {
+	translate Translator
+}
+
+// translateNode translates the attributes and the content of a node,
+// recursively.
+func (t *htmlTranslator) translateNode(n *html.Node) error {
+	if n.Type == html.ElementNode {
+		if isNotTranslatable(n) {
+			return nil
+		}
+		if err := t.translateAttributes(n); err != nil {
+			return err
+		}
+		if _, ok := opaqueElements[n.DataAtom]; ok {
+			return nil
+		}
+	}
+
+	var run []*html.Node
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		if isInlineContent(c) {
+			run = append(run, c)
+		} else {
+			if err := t.translateRun(n, run); err != nil {
+				return err
+			}
+			run = nil
+			if err := t.translateNode(c); err != nil {
+				return err
+			}
+		}
+		c = next
+	}
+	return t.translateRun(n, run)
+}
+
+func (t *htmlTranslator) translateAttributes(n *html.Node) error {
+	for i, attr := range n.Attr {
+		if _, ok := translatableAttributes[attr.Key]; !ok || strings.TrimSpace(attr.Val) == "" {
+			continue
+		}
+		translated, err := t.translate(attr.Val)
+		if err != nil {
+			return err
+		}
+		n.Attr[i].Val = translated
+	}
+	return nil
+}
+
+// translateRun translates a run of consecutive inline contents of the
+// parent node.
+func (t *htmlTranslator) translateRun(parent *html.Node, run []*html.Node) error {
+	// Attributes of nested elements are translated separately.
+	for _, n := range run {
+		if err := t.translateNestedAttributes(n); err != nil {
+			return err
+		}
+	}
+
+	if !containsText(run) {
+		return nil
+	}
+
+	var sb strings.Builder
+	for _, n := range run {
+		writeMarkup(&sb, n)
+	}
+	translated, err := t.translate(sb.String())
+	if err != nil {
+		return err
+	}
+
+	nodes, ok := rebuildRun(translated, run)
+	if !ok {
+		for _, n := range run {
+			if err = t.translateTextNodes(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, n := range nodes {
+		parent.InsertBefore(n, run[0])
+	}
+	for _, n := range run {
+		parent.RemoveChild(n)
+	}
+	return nil
+}
+
+func (t *htmlTranslator) translateNestedAttributes(n *html.Node) error {
+	if n.Type != html.ElementNode {
+		return nil
+	}
+	if isNotTranslatable(n) {
+		return nil
+	}
+	if err := t.translateAttributes(n); err != nil {
+		return err
+	}
+	if _, ok := opaqueElements[n.DataAtom]; ok {
+		return nil
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if err := t.translateNestedAttributes(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// translateTextNodes translates each text node within n on its own.
+func (t *htmlTranslator) translateTextNodes(n *html.Node) error {
+	switch {
+	case n.Type == html.TextNode:
+		if strings.TrimSpace(n.Data) == "" {
+			return nil
+		}
+		translated, err := t.translate(n.Data)
+		if err != nil {
+			return err
+		}
+		n.Data = translated
+		return nil
+	case n.Type == html.ElementNode && isOpaque(n):
+		return nil
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if err := t.translateTextNodes(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isNotTranslatable(n *html.Node) bool {
+	for _, attr := range n.Attr {
+		switch attr.Key {
+		case "translate":
+			if strings.EqualFold(attr.Val, "no") {
+				return true
+			}
+		case "class":
+			for _, class := range strings.Fields(attr.Val) {
+				if class == "notranslate" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// isOpaque reports whether an element is kept as a whole within a run.
+func isOpaque(n *html.Node) bool {
+	_, ok := opaqueInlineElements[n.DataAtom]
+	return ok || isNotTranslatable(n)
+}
+
+// isInlineContent reports whether n can be translated together with the
+// surrounding text.
+func isInlineContent(n *html.Node) bool {
+	switch n.Type {
+	case html.TextNode:
+		return true
+	case html.ElementNode:
+		if isOpaque(n) {
+			return true
+		}
+		if _, ok := inlineElements[n.DataAtom]; !ok {
+			return false
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if !isInlineContent(c) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+func containsText(nodes []*html.Node) bool {
+	for _, n := range nodes {
+		switch {
+		case n.Type == html.TextNode:
+			if strings.TrimSpace(n.Data) != "" {
+				return true
+			}
+		case n.Type == html.ElementNode && !isOpaque(n):
+			var children []*html.Node
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				children = append(children, c)
+			}
+			if containsText(children) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var markupTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;")
+
+// writeMarkup writes the simplified markup of an inline content: inline
+// elements are written without attributes, opaque elements as
+// self-closing tags.
+func writeMarkup(sb *strings.Builder, n *html.Node) {
+	switch {
+	case n.Type == html.TextNode:
+		sb.WriteString(markupTextEscaper.Replace(n.Data))
+	case isOpaque(n):
+		sb.WriteString("<" + n.Data + "/>")
+	default:
+		sb.WriteString("<" + n.Data + ">")
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			writeMarkup(sb, c)
+		}
+		sb.WriteString("</" + n.Data + ">")
+	}
+}
+
+// rebuildRun parses the translated simplified markup of a run, restoring
+// the original elements. It reports false if the markup does not contain
+// exactly the original elements, properly nested.
+func rebuildRun(translated string, run []*html.Node) ([]*html.Node, bool) {
+	inline := make(map[string][]*html.Node)
+	opaque := make(map[string][]*html.Node)
+	for _, n := range run {
+		collectElements(n, inline, opaque)
+	}
+
+	root := &html.Node{Type: html.ElementNode, Data: "div", DataAtom: atom.Div}
+	stack := []*html.Node{root}
+	z := html.NewTokenizer(strings.NewReader(translated))
+	for {
+		tt := z.Next()
+		top := stack[len(stack)-1]
+		switch tt {
+		case html.ErrorToken:
+			if z.Err() != io.EOF || len(stack) != 1 || !allUsed(inline) || !allUsed(opaque) {
+				return nil, false
+			}
+			var nodes []*html.Node
+			for c := root.FirstChild; c != nil; {
+				next := c.NextSibling
+				root.RemoveChild(c)
+				nodes = append(nodes, c)
+				c = next
+			}
+			return nodes, true
+		case html.TextToken:
+			top.AppendChild(&html.Node{Type: html.TextNode, Data: string(z.Text())})
+		case html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			n, ok := shift(opaque, string(name))
+			if !ok {
+				return nil, false
+			}
+			top.AppendChild(cloneTree(n))
+		case html.StartTagToken:
+			name, _ := z.TagName()
+			if n, ok := shift(inline, string(name)); ok {
+				clone := cloneElement(n)
+				top.AppendChild(clone)
+				stack = append(stack, clone)
+				continue
+			}
+			// Void elements may lose their slash.
+			n, ok := shift(opaque, string(name))
+			if !ok {
+				return nil, false
+			}
+			top.AppendChild(cloneTree(n))
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			if len(stack) == 1 || top.Data != string(name) {
+				return nil, false
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			return nil, false
+		}
+	}
+}
+
+// collectElements collects the inline and opaque elements within n, in
+// document order, grouped by tag name.
+func collectElements(n *html.Node, inline, opaque map[string][]*html.Node) {
+	if n.Type != html.ElementNode {
+		return
+	}
+	if isOpaque(n) {
+		opaque[n.Data] = append(opaque[n.Data], n)
+		return
+	}
+	inline[n.Data] = append(inline[n.Data], n)
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		collectElements(c, inline, opaque)
+	}
+}
+
+func shift(m map[string][]*html.Node, name string) (*html.Node, bool) {
+	nodes := m[name]
+	if len(nodes) == 0 {
+		return nil, false
+	}
+	m[name] = nodes[1:]
+	return nodes[0], true
+}
+
+func allUsed(m map[string][]*html.Node) bool {
+	for _, nodes := range m {
+		if len(nodes) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// cloneElement returns a copy of n, without children.
+func cloneElement(n *html.Node) *html.Node {
+	return &html.Node{
+		Type:      n.Type,
+		DataAtom:  n.DataAtom,
+		Data:      n.Data,
+		Namespace: n.Namespace,
+		Attr:      append([]html.Attribute(nil), n.Attr...),
+	}
+}
+
+// cloneTree returns a deep copy of n.
+func cloneTree(n *html.Node) *html.Node {
+	clone := cloneElement(n)
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		clone.AppendChild(cloneTree(c))
+	}
+	return clone
+}
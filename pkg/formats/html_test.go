@@ -0,0 +1,127 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/formats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTranslator returns a Translator which records its inputs and
+// translates them with f.
+func recordingTranslator(inputs *[]string, f func(string) string) formats.Translator {
+	return func(text string) (string, error) {
+		*inputs = append(*inputs, text)
+		return f(text), nil
+	}
+}
+
+func TestTranslateHTML(t *testing.T) {
+	t.Parallel()
+
+	t.Run("inline elements are translated with the text", func(t *testing.T) {
+		t.Parallel()
+		var inputs []string
+		tr := recordingTranslator(&inputs, strings.ToUpper)
+
+		out, err := formats.TranslateHTML(
+			`<p>Click <a href="/next" title="next page">here</a> to <b>continue</b>.</p><p>Bye</p>`, tr)
+		require.NoError(t, err)
+		assert.Equal(t,
+			`<p>CLICK <a href="/next" title="NEXT PAGE">HERE</a> TO <b>CONTINUE</b>.</p><p>BYE</p>`, out)
+		assert.Equal(t, []string{
+			"next page",
+			"Click <a>here</a> to <b>continue</b>.",
+			"Bye",
+		}, inputs)
+	})
+
+	t.Run("inline elements can be moved", func(t *testing.T) {
+		t.Parallel()
+		var inputs []string
+		tr := recordingTranslator(&inputs, func(string) string {
+			return "<b>Bianca</b> è la <i>casa</i>"
+		})
+
+		out, err := formats.TranslateHTML(`<p>The <i>house</i> is <b>white</b></p>`, tr)
+		require.NoError(t, err)
+		assert.Equal(t, `<p><b>Bianca</b> è la <i>casa</i></p>`, out)
+	})
+
+	t.Run("not translatable content", func(t *testing.T) {
+		t.Parallel()
+		var inputs []string
+		tr := recordingTranslator(&inputs, strings.ToUpper)
+
+		out, err := formats.TranslateHTML(
+			`<div><p>Run <code>go test</code> now<br>please</p>`+
+				`<pre>keep me</pre><script>var x = 1;</script>`+
+				`<p translate="no">Brand</p><p><span class="notranslate">ACME</span> rocks</p>`+
+				`<img src="a.png" alt="a cat"></div>`, tr)
+		require.NoError(t, err)
+		assert.Equal(t,
+			`<div><p>RUN <code>go test</code> NOW<br/>PLEASE</p>`+
+				`<pre>keep me</pre><script>var x = 1;</script>`+
+				`<p translate="no">Brand</p><p><span class="notranslate">ACME</span> ROCKS</p>`+
+				`<img src="a.png" alt="A CAT"/></div>`, out)
+		assert.Equal(t, []string{
+			"Run <code/> now<br/>please",
+			"<span/> rocks",
+			"a cat",
+		}, inputs)
+	})
+
+	t.Run("fallback when tags are not preserved", func(t *testing.T) {
+		t.Parallel()
+		var inputs []string
+		tags := regexp.MustCompile(`<[^>]*>`)
+		tr := recordingTranslator(&inputs, func(s string) string {
+			return strings.ToUpper(tags.ReplaceAllString(s, ""))
+		})
+
+		out, err := formats.TranslateHTML(`<p>Hello <b>big</b> world</p>`, tr)
+		require.NoError(t, err)
+		assert.Equal(t, `<p>HELLO <b>BIG</b> WORLD</p>`, out)
+		assert.Equal(t, []string{"Hello <b>big</b> world", "Hello ", "big", " world"}, inputs)
+	})
+
+	t.Run("documents", func(t *testing.T) {
+		t.Parallel()
+		var inputs []string
+		tr := recordingTranslator(&inputs, strings.ToUpper)
+
+		out, err := formats.TranslateHTML(
+			"<!DOCTYPE html><html><head><title>Home</title></head><body><h1>Welcome</h1></body></html>", tr)
+		require.NoError(t, err)
+		assert.Equal(t,
+			"<!DOCTYPE html><html><head><title>HOME</title></head><body><h1>WELCOME</h1></body></html>", out)
+	})
+
+	t.Run("special characters", func(t *testing.T) {
+		t.Parallel()
+		var inputs []string
+		tr := recordingTranslator(&inputs, func(s string) string { return s })
+
+		out, err := formats.TranslateHTML(`<p>1 &lt; 2 &amp; <b>3 &gt; 2</b></p>`, tr)
+		require.NoError(t, err)
+		assert.Equal(t, `<p>1 &lt; 2 &amp; <b>3 &gt; 2</b></p>`, out)
+		assert.Equal(t, []string{"1 &lt; 2 &amp; <b>3 > 2</b>"}, inputs)
+	})
+}
@@ -0,0 +1,526 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"bytes"
+	"errors"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+	"golang.org/x/net/html"
+	"io"
+	"sort"
+	"strings"
+)
+
+// TranslateMarkdown translates a Markdown document, calling translate for
+// each portion of prose (paragraphs, headings, list items and table cells,
+// including GitHub Flavored Markdown extensions).
+//
+// Front matter, code blocks, code spans, HTML, images, and the
+// destinations of links are left untouched. The translation is performed
+// in place on the source document, so that anything which is not
+// translated is preserved exactly as it is.
+//
+// As for HTML (see TranslateHTML), inline markup such as emphasis and links
+// is translated together with the surrounding text as simplified tags.
+// Line breaks within a paragraph are replaced with spaces, and emphasis
+// delimiters may be moved around the translated words. If the translation
+// does not preserve the tags, each piece of text is translated on its own
+// instead.
+func TranslateMarkdown(src string, translate Translator) (string, error) {
+	frontMatter, body := splitFrontMatter(src)
+
+	source := []byte(body)
+	md := goldmark.New(goldmark.WithExtensions(extension.GFM))
+	doc := md.Parser().Parse(text.NewReader(source))
+
+	t := &markdownTranslator{source: source, translate: translate}
+	err := ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering || n.Type() != ast.TypeBlock || !hasInlineChildren(n) {
+			return ast.WalkContinue, nil
+		}
+		return ast.WalkSkipChildren, t.translateBlock(n)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return frontMatter + t.apply(), nil
+}
+
+// splitFrontMatter separates the YAML ("---") or TOML ("+++") front matter
+// from the beginning of a document, if present.
+func splitFrontMatter(src string) (frontMatter, body string) {
+	for _, delim := range []string{"---", "+++"} {
+		if !strings.HasPrefix(src, delim+"\n") && !strings.HasPrefix(src, delim+"\r\n") {
+			continue
+		}
+		offset := strings.IndexByte(src, '\n') + 1
+		for offset < len(src) {
+			end := strings.IndexByte(src[offset:], '\n')
+			if end == -1 {
+				end = len(src)
+			} else {
+				end += offset + 1
+			}
+			line := strings.TrimRight(src[offset:end], "\r\n")
+			if line == delim || (delim == "---" && line == "...") {
+				return src[:end], src[end:]
+			}
+			offset = end
+		}
+	}
+	return "", src
+}
+
+func hasInlineChildren(n ast.Node) bool {
+	c := n.FirstChild()
+	return c != nil && c.Type() == ast.TypeInline
+}
+
+type markdownTranslator struct {
+	source    []byte
+	translate Translator
+	// replacements of source ranges, in document order.
+	replacements []replacement
+}
+
+type replacement struct {
+	start, stop int
+	text        string
+}
+
+// errUnsupportedMarkdown is returned when the source positions of some
+// inline markup cannot be determined.
+var errUnsupportedMarkdown = errors.New("unsupported Markdown markup")
+
+// apply returns the source with all the replacements applied.
+func (t *markdownTranslator) apply() string {
+	sort.SliceStable(t.replacements, func(i, j int) bool {
+		return t.replacements[i].start < t.replacements[j].start
+	})
+	var sb strings.Builder
+	sb.Grow(len(t.source))
+	last := 0
+	for _, r := range t.replacements {
+		sb.Write(t.source[last:r.start])
+		sb.WriteString(r.text)
+		last = r.stop
+	}
+	sb.Write(t.source[last:])
+	return sb.String()
+}
+
+// mdElement is an inline Markdown element represented by a simplified tag
+// in the text to translate.
+type mdElement struct {
+	tag string
+	// open and close are the source of the opening and closing markup.
+	// Opaque elements only have open.
+	open, close string
+	opaque      bool
+}
+
+// translateBlock translates the inline content of a block.
+func (t *markdownTranslator) translateBlock(block ast.Node) error {
+	var children []ast.Node
+	for c := block.FirstChild(); c != nil; c = c.NextSibling() {
+		if c.Kind() == extast.KindTaskCheckBox {
+			continue
+		}
+		children = append(children, c)
+	}
+	if len(children) == 0 || !t.containsText(children) {
+		return nil
+	}
+
+	var sb strings.Builder
+	var elements []*mdElement
+	start, stop, err := t.writeMarkup(&sb, &elements, children)
+	if err != nil {
+		// Unsupported markup: translate each piece of text on its own.
+		return t.translateTexts(children)
+	}
+
+	translated, err := t.translate(sb.String())
+	if err != nil {
+		return err
+	}
+
+	result, ok := rebuildMarkdown(translated, elements)
+	if !ok {
+		return t.translateTexts(children)
+	}
+	t.replacements = append(t.replacements, replacement{start: start, stop: stop, text: result})
+	return nil
+}
+
+// containsText reports whether there is any non-blank text to translate
+// within the given nodes.
+func (t *markdownTranslator) containsText(nodes []ast.Node) bool {
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case *ast.Text:
+			if len(bytes.TrimSpace(n.Segment.Value(t.source))) > 0 {
+				return true
+			}
+		case *ast.Emphasis, *ast.Link, *extast.Strikethrough:
+			var children []ast.Node
+			for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+				children = append(children, c)
+			}
+			if t.containsText(children) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// writeMarkup writes the simplified markup of the given sibling nodes,
+// collecting the elements in document order. It returns the source range
+// covered by the nodes.
+func (t *markdownTranslator) writeMarkup(sb *strings.Builder, elements *[]*mdElement, nodes []ast.Node) (start, stop int, err error) {
+	for i, n := range nodes {
+		nStart, nStop, err := t.bounds(n)
+		if err != nil {
+			return 0, 0, err
+		}
+		if i == 0 {
+			start = nStart
+		} else if gap := t.source[stop:nStart]; len(gap) > 0 {
+			if !bytes.ContainsRune(gap, '\n') {
+				return 0, 0, errUnsupportedMarkdown
+			}
+			if prev, ok := nodes[i-1].(*ast.Text); ok && prev.HardLineBreak() {
+				el := &mdElement{tag: "br", open: string(gap), opaque: true}
+				*elements = append(*elements, el)
+				sb.WriteString("<br/>")
+			} else {
+				sb.WriteByte(' ')
+			}
+		}
+		stop = nStop
+
+		if err = t.writeNodeMarkup(sb, elements, n, nStart, nStop); err != nil {
+			return 0, 0, err
+		}
+	}
+	return start, stop, nil
+}
+
+func (t *markdownTranslator) writeNodeMarkup(sb *strings.Builder, elements *[]*mdElement, n ast.Node, start, stop int) error {
+	src := string(t.source[start:stop])
+
+	var tag string
+	switch n := n.(type) {
+	case *ast.Text:
+		sb.WriteString(markupTextEscaper.Replace(src))
+		return nil
+	case *ast.CodeSpan:
+		tag = "code"
+	case *ast.Image:
+		tag = "img"
+	case *ast.AutoLink:
+		tag = "url"
+	case *ast.RawHTML:
+		tag = "html"
+	case *ast.Emphasis:
+		tag = "em"
+		if n.Level > 1 {
+			tag = "strong"
+		}
+	case *extast.Strikethrough:
+		tag = "s"
+	case *ast.Link:
+		tag = "a"
+	default:
+		return errUnsupportedMarkdown
+	}
+
+	if n.FirstChild() == nil || tag == "code" || tag == "img" || tag == "url" || tag == "html" {
+		*elements = append(*elements, &mdElement{tag: tag, open: src, opaque: true})
+		sb.WriteString("<" + tag + "/>")
+		return nil
+	}
+
+	var children []ast.Node
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		children = append(children, c)
+	}
+	el := &mdElement{tag: tag}
+	*elements = append(*elements, el)
+
+	sb.WriteString("<" + tag + ">")
+	innerStart, innerStop, err := t.writeMarkup(sb, elements, children)
+	if err != nil {
+		return err
+	}
+	sb.WriteString("</" + tag + ">")
+
+	el.open = string(t.source[start:innerStart])
+	el.close = string(t.source[innerStop:stop])
+	return nil
+}
+
+// bounds returns the source range of an inline node, including its markup.
+func (t *markdownTranslator) bounds(n ast.Node) (start, stop int, err error) {
+	src := t.source
+	switch n := n.(type) {
+	case *ast.Text:
+		return n.Segment.Start, n.Segment.Stop, nil
+	case *ast.RawHTML:
+		if n.Segments.Len() == 0 {
+			return 0, 0, errUnsupportedMarkdown
+		}
+		return n.Segments.At(0).Start, n.Segments.At(n.Segments.Len() - 1).Stop, nil
+	case *ast.AutoLink:
+		return t.autoLinkBounds(n)
+	}
+
+	if n.FirstChild() == nil {
+		return 0, 0, errUnsupportedMarkdown
+	}
+	start, _, err = t.bounds(n.FirstChild())
+	if err != nil {
+		return 0, 0, err
+	}
+	_, stop, err = t.bounds(n.LastChild())
+	if err != nil {
+		return 0, 0, err
+	}
+
+	switch n.(type) {
+	case *ast.CodeSpan:
+		start, stop = extendCodeSpan(src, start, stop)
+	case *ast.Emphasis, *extast.Strikethrough:
+		delim := byte('~')
+		if _, ok := n.(*ast.Emphasis); ok {
+			if start == 0 || (src[start-1] != '*' && src[start-1] != '_') {
+				return 0, 0, errUnsupportedMarkdown
+			}
+			delim = src[start-1]
+		}
+		for start > 0 && src[start-1] == delim {
+			start--
+		}
+		for stop < len(src) && src[stop] == delim {
+			stop++
+		}
+	case *ast.Link, *ast.Image:
+		prefix := "["
+		if _, ok := n.(*ast.Image); ok {
+			prefix = "!["
+		}
+		if start < len(prefix) || string(src[start-len(prefix):start]) != prefix {
+			return 0, 0, errUnsupportedMarkdown
+		}
+		start -= len(prefix)
+		if stop >= len(src) || src[stop] != ']' {
+			return 0, 0, errUnsupportedMarkdown
+		}
+		stop = linkTailEnd(src, stop+1)
+	default:
+		return 0, 0, errUnsupportedMarkdown
+	}
+	return start, stop, nil
+}
+
+// autoLinkBounds finds the source range of an autolink, after the end of
+// its previous sibling.
+func (t *markdownTranslator) autoLinkBounds(n *ast.AutoLink) (int, int, error) {
+	from := 0
+	if prev := n.PreviousSibling(); prev != nil {
+		_, prevStop, err := t.bounds(prev)
+		if err != nil {
+			return 0, 0, err
+		}
+		from = prevStop
+	} else if n.Parent() != nil && n.Parent().Lines().Len() > 0 {
+		from = n.Parent().Lines().At(0).Start
+	}
+
+	label := n.Label(t.source)
+	i := bytes.Index(t.source[from:], label)
+	if i == -1 {
+		return 0, 0, errUnsupportedMarkdown
+	}
+	start, stop := from+i, from+i+len(label)
+	if start > 0 && t.source[start-1] == '<' && stop < len(t.source) && t.source[stop] == '>' {
+		start--
+		stop++
+	}
+	return start, stop, nil
+}
+
+// extendCodeSpan extends the range of the content of a code span to its
+// backtick delimiters (and the optional padding spaces).
+func extendCodeSpan(src []byte, start, stop int) (int, int) {
+	if start > 1 && src[start-1] == ' ' && src[start-2] == '`' {
+		start--
+	}
+	for start > 0 && src[start-1] == '`' {
+		start--
+	}
+	if stop+1 < len(src) && src[stop] == ' ' && src[stop+1] == '`' {
+		stop++
+	}
+	for stop < len(src) && src[stop] == '`' {
+		stop++
+	}
+	return start, stop
+}
+
+// linkTailEnd returns the end of the destination part of a link or image,
+// starting right after the closing bracket of its text: an inline
+// destination "(...)", a reference "[...]", or nothing.
+func linkTailEnd(src []byte, i int) int {
+	if i >= len(src) {
+		return i
+	}
+	switch src[i] {
+	case '(':
+		depth := 0
+		var quote byte
+		for j := i; j < len(src); j++ {
+			c := src[j]
+			switch {
+			case c == '\\':
+				j++
+			case quote != 0:
+				if c == quote {
+					quote = 0
+				}
+			case c == '"' || c == '\'':
+				quote = c
+			case c == '(':
+				depth++
+			case c == ')':
+				depth--
+				if depth == 0 {
+					return j + 1
+				}
+			}
+		}
+	case '[':
+		if j := bytes.IndexByte(src[i:], ']'); j != -1 {
+			return i + j + 1
+		}
+	}
+	return i
+}
+
+// translateTexts translates each text node within the given nodes on its
+// own, leaving any markup untouched.
+func (t *markdownTranslator) translateTexts(nodes []ast.Node) error {
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case *ast.Text:
+			value := n.Segment.Value(t.source)
+			if len(bytes.TrimSpace(value)) == 0 {
+				continue
+			}
+			translated, err := t.translate(string(value))
+			if err != nil {
+				return err
+			}
+			t.replacements = append(t.replacements, replacement{
+				start: n.Segment.Start,
+				stop:  n.Segment.Stop,
+				text:  translated,
+			})
+		case *ast.Emphasis, *ast.Link, *extast.Strikethrough:
+			var children []ast.Node
+			for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+				children = append(children, c)
+			}
+			if err := t.translateTexts(children); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rebuildMarkdown parses the translated simplified markup, restoring the
+// original Markdown markup of the elements. It reports false if the markup
+// does not contain exactly the original elements, properly nested.
+func rebuildMarkdown(translated string, elements []*mdElement) (string, bool) {
+	queues := make(map[string][]*mdElement)
+	for _, el := range elements {
+		queues[el.tag] = append(queues[el.tag], el)
+	}
+	next := func(tag string, opaque bool) (*mdElement, bool) {
+		q := queues[tag]
+		if len(q) == 0 || q[0].opaque != opaque {
+			return nil, false
+		}
+		queues[tag] = q[1:]
+		return q[0], true
+	}
+
+	var sb strings.Builder
+	var stack []*mdElement
+	z := html.NewTokenizer(strings.NewReader(translated))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if z.Err() != io.EOF || len(stack) > 0 {
+				return "", false
+			}
+			for _, q := range queues {
+				if len(q) > 0 {
+					return "", false
+				}
+			}
+			return sb.String(), true
+		case html.TextToken:
+			sb.Write(z.Text())
+		case html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			el, ok := next(string(name), true)
+			if !ok {
+				return "", false
+			}
+			sb.WriteString(el.open)
+		case html.StartTagToken:
+			name, _ := z.TagName()
+			if el, ok := next(string(name), false); ok {
+				sb.WriteString(el.open)
+				stack = append(stack, el)
+				continue
+			}
+			// Opaque elements may lose their slash.
+			el, ok := next(string(name), true)
+			if !ok {
+				return "", false
+			}
+			sb.WriteString(el.open)
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			if len(stack) == 0 || stack[len(stack)-1].tag != string(name) {
+				return "", false
+			}
+			sb.WriteString(stack[len(stack)-1].close)
+			stack = stack[:len(stack)-1]
+		default:
+			return "", false
+		}
+	}
+}
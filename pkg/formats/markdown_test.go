@@ -0,0 +1,120 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/formats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateMarkdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("structure is preserved", func(t *testing.T) {
+		t.Parallel()
+		var inputs []string
+		tr := recordingTranslator(&inputs, strings.ToUpper)
+
+		src := "---\ntitle: Hello\n---\n" +
+			"# Getting started\n\n" +
+			"Run `go test` and read the [docs](https://example.com/docs \"Docs\").\n" +
+			"It is *really* **easy**.\n\n" +
+			"> Quoted text\n\n" +
+			"- [ ] first task\n" +
+			"- ~~second~~ item ![logo](logo.png)\n\n" +
+			"```go\nfmt.Println(\"hi\")\n```\n\n" +
+			"| Name | Value |\n|------|-------|\n| one | <https://example.com> |\n\n" +
+			"See [the guide][guide].\n\n" +
+			"[guide]: https://example.com/guide\n"
+
+		out, err := formats.TranslateMarkdown(src, tr)
+		require.NoError(t, err)
+		assert.Equal(t, "---\ntitle: Hello\n---\n"+
+			"# GETTING STARTED\n\n"+
+			"RUN `go test` AND READ THE [DOCS](https://example.com/docs \"Docs\"). "+
+			"IT IS *REALLY* **EASY**.\n\n"+
+			"> QUOTED TEXT\n\n"+
+			"- [ ] FIRST TASK\n"+
+			"- ~~SECOND~~ ITEM ![logo](logo.png)\n\n"+
+			"```go\nfmt.Println(\"hi\")\n```\n\n"+
+			"| NAME | VALUE |\n|------|-------|\n| ONE | <https://example.com> |\n\n"+
+			"SEE [THE GUIDE][guide].\n\n"+
+			"[guide]: https://example.com/guide\n", out)
+		assert.Equal(t, []string{
+			"Getting started",
+			"Run <code/> and read the <a>docs</a>. It is <em>really</em> <strong>easy</strong>.",
+			"Quoted text",
+			"first task",
+			"<s>second</s> item <img/>",
+			"Name",
+			"Value",
+			"one",
+			"See <a>the guide</a>.",
+		}, inputs)
+	})
+
+	t.Run("inline elements can be moved", func(t *testing.T) {
+		t.Parallel()
+		var inputs []string
+		tr := recordingTranslator(&inputs, func(string) string {
+			return "<strong>Bianca</strong> è la <em>casa</em>"
+		})
+
+		out, err := formats.TranslateMarkdown("The _house_ is __white__\n", tr)
+		require.NoError(t, err)
+		assert.Equal(t, "__Bianca__ è la _casa_\n", out)
+	})
+
+	t.Run("hard line breaks", func(t *testing.T) {
+		t.Parallel()
+		var inputs []string
+		tr := recordingTranslator(&inputs, strings.ToUpper)
+
+		out, err := formats.TranslateMarkdown("Roses are red,  \nviolets are blue\n", tr)
+		require.NoError(t, err)
+		assert.Equal(t, "ROSES ARE RED,  \nVIOLETS ARE BLUE\n", out)
+		assert.Equal(t, []string{"Roses are red,<br/>violets are blue"}, inputs)
+	})
+
+	t.Run("fallback when tags are not preserved", func(t *testing.T) {
+		t.Parallel()
+		var inputs []string
+		tags := regexp.MustCompile(`<[^>]*>`)
+		tr := recordingTranslator(&inputs, func(s string) string {
+			return strings.ToUpper(tags.ReplaceAllString(s, ""))
+		})
+
+		out, err := formats.TranslateMarkdown("Hello *big* world\n", tr)
+		require.NoError(t, err)
+		assert.Equal(t, "HELLO *BIG* WORLD\n", out)
+		assert.Equal(t, []string{"Hello <em>big</em> world", "Hello ", "big", " world"}, inputs)
+	})
+
+	t.Run("special characters", func(t *testing.T) {
+		t.Parallel()
+		var inputs []string
+		tr := recordingTranslator(&inputs, func(s string) string { return s })
+
+		out, err := formats.TranslateMarkdown("1 < 2 & 3 > 2\n", tr)
+		require.NoError(t, err)
+		assert.Equal(t, "1 < 2 & 3 > 2\n", out)
+		assert.Equal(t, []string{"1 &lt; 2 &amp; 3 > 2"}, inputs)
+	})
+}
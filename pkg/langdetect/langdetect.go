@@ -0,0 +1,238 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package langdetect provides offline language identification, based on
+// character n-gram profiles built from small sample texts bundled with the
+// binary.
+package langdetect
+
+import (
+	"embed"
+	"math"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxNGramSize is the maximum length, in characters, of the n-grams.
+const maxNGramSize = 3
+
+const (
+	// minCoverage is the minimum fraction of the trigrams (n-grams of
+	// maxNGramSize) of a text which must occur in the sample text of the
+	// detected language. Texts in languages which are not known to the Detector
+	// share fewer trigrams with the sample texts, even when the candidate
+	// languages share their alphabet.
+	minCoverage = 0.5
+	// minCoverageTrigrams is the minimum amount of trigrams of a text for
+	// minCoverage to be checked. Shorter texts, such as single words, are
+	// not long enough for a reliable estimate.
+	minCoverageTrigrams = 10
+)
+
+//go:embed corpora/*.txt
+var corpora embed.FS
+
+// Result is the detection score of a single language.
+type Result struct {
+	// Language is the ISO 639-1 code of the language.
+	Language string
+	// Confidence is the estimated probability of the language, in range
+	// [0, 1], among the candidate languages. It is relative to the other
+	// candidates: how likely the text is to be in any of them at all is
+	// checked by Detect.
+	Confidence float64
+}
+
+// Detector identifies the language of texts.
+// It is safe for concurrent use.
+type Detector struct {
+	profiles map[string]*profile
+}
+
+// profile holds the n-grams log-probabilities of a language.
+type profile struct {
+	logProbs map[string]float64
+	// unseen is the log-probability of an n-gram not in logProbs.
+	unseen float64
+}
+
+var (
+	defaultDetector     *Detector
+	defaultDetectorOnce sync.Once
+)
+
+// Default returns a Detector for all the bundled languages.
+func Default() *Detector {
+	defaultDetectorOnce.Do(func() {
+		defaultDetector = newDefaultDetector()
+	})
+	return defaultDetector
+}
+
+func newDefaultDetector() *Detector {
+	entries, err := corpora.ReadDir("corpora")
+	if err != nil {
+		panic(err)
+	}
+	d := &Detector{profiles: make(map[string]*profile, len(entries))}
+	for _, e := range entries {
+		content, err := corpora.ReadFile(path.Join("corpora", e.Name()))
+		if err != nil {
+			panic(err)
+		}
+		lang := strings.TrimSuffix(e.Name(), path.Ext(e.Name()))
+		d.profiles[lang] = newProfile(string(content))
+	}
+	return d
+}
+
+// newProfile builds a profile from a sample text, estimating the
+// probabilities of its n-grams with add-one smoothing.
+func newProfile(text string) *profile {
+	counts := make(map[string]int)
+	total := 0
+	forEachNGram(text, func(ng string) {
+		counts[ng]++
+		total++
+	})
+
+	denominator := math.Log(float64(total + len(counts) + 1))
+	p := &profile{
+		logProbs: make(map[string]float64, len(counts)),
+		unseen:   -denominator,
+	}
+	for ng, count := range counts {
+		p.logProbs[ng] = math.Log(float64(count+1)) - denominator
+	}
+	return p
+}
+
+// Languages returns the codes of the languages known to the Detector,
+// sorted alphabetically.
+func (d *Detector) Languages() []string {
+	languages := make([]string, 0, len(d.profiles))
+	for lang := range d.profiles {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// Detect scores the candidate languages for the given text, returning the
+// results sorted by descending confidence. If no candidates are given, all
+// known languages are considered; unknown candidates are ignored.
+//
+// It returns nil if there are no known candidates, if the text does not
+// contain any letter, or if the text is long enough to tell that it is not
+// written in any of the candidates, that is, too few of its trigrams occur
+// in the sample text of the most likely language (see minCoverage).
+func (d *Detector) Detect(text string, candidates ...string) []Result {
+	if len(candidates) == 0 {
+		candidates = d.Languages()
+	}
+
+	var ngrams []string
+	numTrigrams := 0
+	forEachNGram(text, func(ng string) {
+		ngrams = append(ngrams, ng)
+		if isTrigram(ng) {
+			numTrigrams++
+		}
+	})
+	if len(ngrams) == 0 {
+		return nil
+	}
+
+	results := make([]Result, 0, len(candidates))
+	maxScore := math.Inf(-1)
+	// bestSeenTrigrams is the amount of trigrams of the text which occur in
+	// the sample text of the most likely language.
+	bestSeenTrigrams := 0
+	for _, lang := range candidates {
+		p, ok := d.profiles[lang]
+		if !ok {
+			continue
+		}
+		score := 0.0
+		seenTrigrams := 0
+		for _, ng := range ngrams {
+			if lp, ok := p.logProbs[ng]; ok {
+				score += lp
+				if isTrigram(ng) {
+					seenTrigrams++
+				}
+			} else {
+				score += p.unseen
+			}
+		}
+		results = append(results, Result{Language: lang, Confidence: score})
+		if score > maxScore {
+			maxScore = score
+			bestSeenTrigrams = seenTrigrams
+		}
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	if numTrigrams >= minCoverageTrigrams && float64(bestSeenTrigrams) < minCoverage*float64(numTrigrams) {
+		return nil
+	}
+
+	// Convert log-likelihoods to posterior probabilities (assuming
+	// uniform priors), in a numerically stable way.
+	sum := 0.0
+	for i := range results {
+		results[i].Confidence = math.Exp(results[i].Confidence - maxScore)
+		sum += results[i].Confidence
+	}
+	for i := range results {
+		results[i].Confidence /= sum
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Confidence > results[j].Confidence
+	})
+	return results
+}
+
+// isTrigram reports whether the n-gram is of maxNGramSize.
+func isTrigram(ng string) bool {
+	return utf8.RuneCountInString(ng) == maxNGramSize
+}
+
+// forEachNGram calls f for each n-gram of the words of the text.
+// Words are sequences of letters, lowercased and padded with spaces.
+func forEachNGram(text string, f func(string)) {
+	for _, word := range strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\''
+	}) {
+		word = strings.Trim(word, "'")
+		if word == "" {
+			continue
+		}
+		runes := []rune(" " + strings.ToLower(word) + " ")
+		for n := 1; n <= maxNGramSize; n++ {
+			for i := 0; i+n <= len(runes); i++ {
+				ng := string(runes[i : i+n])
+				if ng != " " {
+					f(ng)
+				}
+			}
+		}
+	}
+}
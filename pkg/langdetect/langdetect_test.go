@@ -0,0 +1,108 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package langdetect_test
+
+import (
+	"github.com/SpecializedGeneralist/translator/pkg/langdetect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDetector_Detect(t *testing.T) {
+	t.Parallel()
+
+	d := langdetect.Default()
+
+	t.Run("bundled languages", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t,
+			[]string{"de", "en", "es", "fr", "it", "nl", "pl", "pt", "ro", "sv"},
+			d.Languages())
+	})
+
+	testCases := []struct {
+		text     string
+		expected string
+	}{
+		{"The quick brown fox jumps over the lazy dog.", "en"},
+		{"Oggi è una bella giornata, andiamo al mare con gli amici.", "it"},
+		{"Ich habe heute keine Zeit, weil ich arbeiten muss.", "de"},
+		{"Je ne sais pas où il est allé hier soir.", "fr"},
+		{"¿Dónde está la biblioteca? Necesito un libro nuevo.", "es"},
+		{"Não sei se ele vai chegar a tempo para o jantar.", "pt"},
+		{"Ik weet niet waarom hij gisteren niet gekomen is.", "nl"},
+		{"Jag vet inte varför han inte kom igår kväll.", "sv"},
+		{"Nie wiem, dlaczego on nie przyszedł wczoraj wieczorem.", "pl"},
+		{"Nu știu de ce nu a venit aseară la petrecere.", "ro"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.expected, func(t *testing.T) {
+			t.Parallel()
+			results := d.Detect(tc.text)
+			require.NotEmpty(t, results)
+			assert.Equal(t, tc.expected, results[0].Language)
+			assert.Greater(t, results[0].Confidence, 0.5)
+		})
+	}
+
+	t.Run("candidates", func(t *testing.T) {
+		t.Parallel()
+		results := d.Detect("Il treno per Milano parte alle otto.", "it", "de", "xx")
+		require.Len(t, results, 2)
+		assert.Equal(t, "it", results[0].Language)
+		assert.InDelta(t, 1.0, results[0].Confidence+results[1].Confidence, 1e-9)
+	})
+
+	t.Run("text in none of the candidates", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, d.Detect("The quick brown fox jumps over the lazy dog.", "it", "de"))
+	})
+
+	unknownLanguages := []struct {
+		language string
+		text     string
+	}{
+		{"fi", "Minä en tiedä, miksi hän ei tullut eilen illalla."},
+		{"hu", "Nem tudom, miért nem jött el tegnap este."},
+		{"tr", "Dün akşam neden gelmediğini bilmiyorum."},
+		{"id", "Saya tidak tahu mengapa dia tidak datang tadi malam."},
+		{"cs", "Nevím, proč včera večer nepřišel."},
+		{"is", "Ég veit ekki af hverju hann kom ekki í gærkvöldi."},
+		{"ru", "Я не знаю, почему он не пришёл вчера вечером."},
+		{"zh", "我不知道他昨晚为什么没来。"},
+	}
+	for _, tc := range unknownLanguages {
+		tc := tc
+		t.Run("unknown language "+tc.language, func(t *testing.T) {
+			t.Parallel()
+			assert.Nil(t, d.Detect(tc.text))
+		})
+	}
+
+	t.Run("short texts", func(t *testing.T) {
+		t.Parallel()
+		results := d.Detect("Ciao")
+		require.NotEmpty(t, results)
+		assert.Equal(t, "it", results[0].Language)
+	})
+
+	t.Run("no letters", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, d.Detect(" 123 !? "))
+		assert.Nil(t, d.Detect("hello", "xx"))
+	})
+}
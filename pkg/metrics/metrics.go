@@ -0,0 +1,212 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics provides the Prometheus metrics of the translator.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const namespace = "translator"
+
+// Label values for Errors kinds.
+const (
+	// ErrorKindError identifies ordinary errors, such as invalid requests.
+	ErrorKindError = "error"
+	// ErrorKindFatal identifies unexpected errors, such as panics.
+	ErrorKindFatal = "fatal"
+)
+
+// Label values for Fallbacks reasons.
+const (
+	// FallbackReasonError identifies translations which returned an error.
+	FallbackReasonError = "error"
+	// FallbackReasonPanic identifies translations which panicked.
+	FallbackReasonPanic = "panic"
+	// FallbackReasonDeadline identifies translations which exceeded the
+	// configured timeout.
+	FallbackReasonDeadline = "deadline"
+)
+
+// Label values for Fallbacks kinds.
+const (
+	// FallbackKindModel identifies translations served by an alternate
+	// model.
+	FallbackKindModel = "model"
+	// FallbackKindPivot identifies translations served through
+	// intermediate languages.
+	FallbackKindPivot = "pivot"
+	// FallbackKindUntranslated identifies source texts returned
+	// untranslated.
+	FallbackKindUntranslated = "untranslated"
+	// FallbackKindNone identifies translations whose fallbacks all failed.
+	FallbackKindNone = "none"
+)
+
+// UnknownLabel is the label value used in place of unknown (e.g. not
+// supported) source and target languages.
+const UnknownLabel = "unknown"
+
+var (
+	// Translations counts the translation requests, by source and target
+	// language.
+	Translations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "translations_total",
+		Help:      "Total number of translation requests.",
+	}, []string{"source", "target"})
+
+	// Errors counts the errors returned to clients, by kind (see
+	// ErrorKindError and ErrorKindFatal).
+	Errors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "errors_total",
+		Help:      "Total number of errors returned to clients.",
+	}, []string{"kind"})
+
+	// Fallbacks counts the failed translations handled by the configured
+	// fallbacks, by reason (see FallbackReasonError, FallbackReasonPanic
+	// and FallbackReasonDeadline) and kind of the fallback which served
+	// them (see FallbackKindModel, FallbackKindPivot,
+	// FallbackKindUntranslated and FallbackKindNone).
+	Fallbacks = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "fallbacks_total",
+		Help:      "Total number of failed translations handled by the configured fallbacks.",
+	}, []string{"reason", "fallback"})
+
+	// TranslationDuration observes the duration of successful translations,
+	// by source and target language.
+	TranslationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "translation_duration_seconds",
+		Help:      "Duration of successful translations, excluding queue waiting time.",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 25, 60, 120},
+	}, []string{"source", "target"})
+
+	// InputTokens counts the tokens given in input to each model.
+	InputTokens = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "input_tokens_total",
+		Help:      "Total number of tokens given in input to a model.",
+	}, []string{"model"})
+
+	// OutputTokens counts the tokens generated by each model.
+	OutputTokens = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "output_tokens_total",
+		Help:      "Total number of tokens generated by a model.",
+	}, []string{"model"})
+
+	// BatchSize observes the amount of requests computed together by each
+	// model, when batching is enabled.
+	BatchSize = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "batch_size",
+		Help:      "Number of requests computed together in a batch (batching only).",
+		Buckets:   []float64{1, 2, 4, 8, 16, 32, 64},
+	}, []string{"model"})
+
+	// QueueRunningJobs is the number of jobs currently running on the
+	// processing queue.
+	QueueRunningJobs = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "queue_running_jobs",
+		Help:      "Number of jobs running on the processing queue.",
+	})
+
+	// QueueWaitingJobs is the number of jobs currently waiting for a free
+	// slot on the processing queue.
+	QueueWaitingJobs = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "queue_waiting_jobs",
+		Help:      "Number of jobs waiting for a free slot on the processing queue.",
+	})
+
+	// QueueWaitDuration observes how long jobs wait for a free slot on the
+	// processing queue.
+	QueueWaitDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "queue_wait_duration_seconds",
+		Help:      "Time spent by jobs waiting for a free slot on the processing queue.",
+		Buckets:   []float64{.001, .01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+	})
+
+	// ResidentModels is the number of models currently loaded on demand,
+	// when lazy loading is enabled.
+	ResidentModels = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "resident_models",
+		Help:      "Number of models loaded on demand (lazy loading only).",
+	})
+
+	// ModelEvictions counts the models unloaded to satisfy the lazy loading
+	// limits.
+	ModelEvictions = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "model_evictions_total",
+		Help:      "Total number of least recently used models unloaded to satisfy the lazy loading limits.",
+	})
+
+	// CacheHits counts the translations served from the cache.
+	CacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_hits_total",
+		Help:      "Total number of translations found in the cache.",
+	})
+
+	// CacheMisses counts the translations not found in the cache.
+	CacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_misses_total",
+		Help:      "Total number of translations not found in the cache.",
+	})
+
+	// CacheEntries is the number of entries currently in the cache.
+	CacheEntries = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "cache_entries",
+		Help:      "Number of translations in the cache.",
+	})
+)
+
+// Registry is the Prometheus registry of all the translator metrics,
+// including Go runtime and process metrics.
+var Registry = newRegistry()
+
+func newRegistry() *prometheus.Registry {
+	r := prometheus.NewRegistry()
+	r.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		Translations,
+		Errors,
+		Fallbacks,
+		TranslationDuration,
+		InputTokens,
+		OutputTokens,
+		BatchSize,
+		QueueRunningJobs,
+		QueueWaitingJobs,
+		QueueWaitDuration,
+		ResidentModels,
+		ModelEvictions,
+		CacheHits,
+		CacheMisses,
+		CacheEntries,
+	)
+	return r
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import "strings"
+
+// ordinalNumbersKey is a special entry of an abbreviations set, reporting
+// that numbers followed by a period are ordinals in that language, and
+// therefore they do not terminate a sentence.
+const ordinalNumbersKey = "#"
+
+// abbreviations maps a base language code to the set of its common
+// abbreviations, lowercase and without the final period.
+var abbreviations = map[string]map[string]struct{}{
+	"en": newAbbreviationsSet(
+		"mr mrs ms dr prof sr jr st mt ft vs etc e.g i.e inc ltd co corp " +
+			"dept est approx no nos vol fig p pp ed eds rev gen col lt sgt capt " +
+			"jan feb mar apr jun jul aug sep sept oct nov dec u.s u.k a.m p.m",
+	),
+	"it": newAbbreviationsSet(
+		"sig sigg sig.ra dott dott.ssa prof prof.ssa ing avv arch geom rag " +
+			"on sen dr ecc pag pagg es cap art n nn tel vol ca cfr rif sez fig " +
+			"gen genn feb mar apr mag giu lug ago sett ott nov dic s.p.a s.r.l",
+	),
+	"de": newAbbreviationsSet(
+		ordinalNumbersKey + " z.b bzw usw ca dr prof nr str vgl ggf evtl u.a d.h " +
+			"s.o s.u inkl zzgl bspw sog tel hr fr abs abb bd jh jhd mio mrd " +
+			"jan feb mär apr jun jul aug sep sept okt nov dez",
+	),
+	"fr": newAbbreviationsSet(
+		"m mm mme mmes mlle mlles dr pr me st ste etc cf p.ex av apr j.-c " +
+			"env vol éd fig p pp no n° tél janv févr avr juil sept oct nov déc",
+	),
+	"es": newAbbreviationsSet(
+		"sr sres sra sras srta dr dra ud uds lic ing etc pág págs núm vol " +
+			"cap fig tel aprox p.ej ee.uu av avda c/ ene feb mar abr jun jul " +
+			"ago sept oct nov dic",
+	),
+	"pt": newAbbreviationsSet(
+		"sr srs sra sras srta dr dra prof profa eng etc pág págs núm n.º " +
+			"vol cap fig tel av p.ex aprox jan fev mar abr mai jun jul ago set " +
+			"out nov dez",
+	),
+	"nl": newAbbreviationsSet(
+		ordinalNumbersKey + " dhr mevr mr dr prof ir ing drs bijv enz etc o.a " +
+			"d.w.z m.a.w i.p.v t.a.v nr blz jan feb mrt apr jun jul aug sep " +
+			"okt nov dec",
+	),
+}
+
+func newAbbreviationsSet(list string) map[string]struct{} {
+	fields := strings.Fields(list)
+	set := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		set[f] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,120 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// LoadModel loads a model of a language pair, if it is not loaded yet,
+// returning the matching language pair, with that Model. The model is the
+// one with the given name, or the first model of the pair if the name is
+// empty. Language codes are matched as described for LookupPair.
+//
+// With lazy loading, the model is loaded as if it were used for a
+// translation, so it might be evicted later, like any other model.
+func (mng *Manager) LoadModel(source, target, name string) (LanguagePair, error) {
+	pair, err := mng.LookupModel(source, target, name)
+	if err != nil {
+		return LanguagePair{}, err
+	}
+
+	if mng.resident != nil {
+		if err = mng.resident.acquire(pair.Model); err != nil {
+			return LanguagePair{}, err
+		}
+		mng.resident.release(pair.Model)
+		return pair, nil
+	}
+
+	if pair.Model.Status() == StatusLoaded {
+		return pair, nil
+	}
+	// The model might have been loaded concurrently, in the meantime.
+	if err = pair.Model.Load(); err != nil && pair.Model.Status() != StatusLoaded {
+		return LanguagePair{}, err
+	}
+	return pair, nil
+}
+
+// UnloadModel unloads a model of a language pair, returning the matching
+// language pair, with that Model. The model and the language pair are
+// selected as described for LoadModel. In-progress translations are
+// completed before the model is released.
+//
+// Without lazy loading, the model is not loaded again until LoadModel is
+// called, and translations for the language pair fail in the meantime.
+func (mng *Manager) UnloadModel(source, target, name string) (LanguagePair, error) {
+	pair, err := mng.LookupModel(source, target, name)
+	if err != nil {
+		return LanguagePair{}, err
+	}
+	mng.resident.forget(pair.Model)
+	if err = pair.Model.Unload(); err != nil {
+		return LanguagePair{}, err
+	}
+	return pair, nil
+}
+
+// LookupModel is like LookupPair, but the Model of the returned language
+// pair is the one with the given name, or the first model of the pair if
+// the name is empty. It returns an error if no such model is found.
+func (mng *Manager) LookupModel(source, target, name string) (LanguagePair, error) {
+	pair, ok := mng.LookupPair(source, target)
+	if !ok {
+		return LanguagePair{}, fmt.Errorf("no model for translation from %#v to %#v", source, target)
+	}
+	if name == "" {
+		return pair, nil
+	}
+	model, ok := pair.modelNamed(name)
+	if !ok {
+		return LanguagePair{}, fmt.Errorf("model %#v is not available for translation from %#v to %#v", name, source, target)
+	}
+	pair.Model = model
+	return pair, nil
+}
+
+// ResidentModel describes a loaded model.
+type ResidentModel struct {
+	LanguagePair
+	// InUse is the amount of translations currently using the model, and
+	// LastUsed is when the model was last used. They are only tracked
+	// with lazy loading.
+	InUse    int
+	LastUsed time.Time
+}
+
+// ResidentModels returns the currently loaded models, sorted by source and
+// target language, each one as the Model of its language pair.
+func (mng *Manager) ResidentModels() []ResidentModel {
+	var resident []ResidentModel
+	for _, pair := range mng.LanguagePairs() {
+		for _, wm := range pair.Models {
+			if wm.Model.Status() != StatusLoaded {
+				continue
+			}
+			inUse, lastUsed, _ := mng.resident.usage(wm.Model)
+			pair.Model = wm.Model
+			resident = append(resident, ResidentModel{
+				LanguagePair: pair,
+				InUse:        inUse,
+				LastUsed:     lastUsed,
+			})
+		}
+	}
+	return resident
+}
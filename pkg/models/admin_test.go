@@ -0,0 +1,85 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagerLoadUnloadModel(t *testing.T) {
+	t.Parallel()
+
+	config := &configuration.Config{
+		LazyLoading: configuration.LazyLoading{Enabled: true},
+		LanguageModels: []configuration.LanguageModel{
+			{Source: "en", Target: "it", Model: "en-it"},
+			{Source: "it", Target: "en", Model: "it-en"},
+		},
+	}
+	mng := NewManager(config, zerolog.Nop())
+	var loads int32
+	mng.resident = newTestResidentModels(0, &loads)
+	require.NoError(t, mng.LoadModels())
+	assert.Empty(t, mng.ResidentModels())
+
+	pair, err := mng.LoadModel("EN", "it", "")
+	require.NoError(t, err)
+	assert.Equal(t, "en-it", pair.Model.Name())
+	assert.Equal(t, StatusLoaded, pair.Model.Status())
+
+	resident := mng.ResidentModels()
+	require.Len(t, resident, 1)
+	assert.Equal(t, "en", resident[0].Source)
+	assert.Equal(t, 0, resident[0].InUse)
+	assert.False(t, resident[0].LastUsed.IsZero())
+
+	_, err = mng.LoadModel("en", "de", "")
+	assert.Error(t, err)
+
+	// The fake models have no underlying spaGO model to release.
+	mng.resident.forget(pair.Model)
+	pair.Model.setStatus(StatusNotLoaded)
+	assert.Empty(t, mng.ResidentModels())
+	_, err = mng.UnloadModel("en", "it", "")
+	assert.EqualError(t, err, "model not loaded")
+}
+
+func TestModelLoadProgress(t *testing.T) {
+	t.Parallel()
+
+	config := &configuration.Config{ModelsPath: t.TempDir()}
+	model := NewModel(config, configuration.LanguageModel{Model: "m"}, zerolog.Nop())
+
+	_, ok := model.LoadProgress()
+	assert.False(t, ok)
+
+	require.NoError(t, os.Mkdir(model.Path(), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(model.Path(), "pytorch_model.bin.tmp"), make([]byte, 42), 0644))
+	model.setStatus(StatusLoading)
+	model.setStage(LoadStageDownloading)
+
+	progress, ok := model.LoadProgress()
+	require.True(t, ok)
+	assert.Equal(t, LoadStageDownloading, progress.Stage)
+	assert.Equal(t, int64(42), progress.DownloadedBytes)
+	assert.False(t, progress.StageStart.IsZero())
+}
@@ -0,0 +1,153 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+)
+
+// generationInput is the input of a single generation, as submitted to a
+// batcher.
+type generationInput struct {
+	inputIDs []int
+	params   configuration.GenerationParams
+	// n is the maximum amount of hypotheses, as for generator.generate.
+	n    int
+	rand *rand.Rand
+}
+
+// batcher collects the generation inputs submitted within a short time
+// window, and computes them together, in a single batch.
+type batcher struct {
+	// window is how long the first input of a batch waits for more inputs.
+	window time.Duration
+	// maxSize is the maximum amount of inputs in a batch (0 means no limit).
+	maxSize int
+	// maxTokens is the maximum sum of the input tokens of a batch (0 means
+	// no limit).
+	maxTokens int
+	// run computes a batch, returning the hypotheses of each input.
+	run func([]generationInput) [][]hypothesis
+
+	// mu protects current.
+	mu sync.Mutex
+	// current is the batch collecting new inputs, if any.
+	current *batch
+}
+
+// batch is a group of inputs computed together.
+type batch struct {
+	items  []*batchItem
+	tokens int
+	timer  *time.Timer
+}
+
+// batchItem is an input of a batch, together with its outcome.
+type batchItem struct {
+	input  generationInput
+	done   chan struct{}
+	result []hypothesis
+	// panicValue is the value of the panic occurred computing the batch,
+	// if any.
+	panicValue interface{}
+}
+
+// newBatcher returns a new batcher for the given configuration, or nil if
+// batching is disabled.
+func newBatcher(config configuration.Batching, run func([]generationInput) [][]hypothesis) *batcher {
+	if config.Window <= 0 {
+		return nil
+	}
+	return &batcher{
+		window:    config.Window,
+		maxSize:   config.MaxBatchSize,
+		maxTokens: config.MaxBatchTokens,
+		run:       run,
+	}
+}
+
+// submit adds an input to the current batch, starting a new one if
+// necessary, and returns its hypotheses once the batch is computed.
+//
+// A batch is computed as soon as the window of its first input expires,
+// or it is full. A panic occurred computing the batch is propagated to all
+// its submitters.
+func (b *batcher) submit(input generationInput) []hypothesis {
+	item := &batchItem{input: input, done: make(chan struct{})}
+	tokens := len(input.inputIDs)
+
+	b.mu.Lock()
+	if b.current != nil && b.maxTokens > 0 && b.current.tokens+tokens > b.maxTokens {
+		b.flush(b.current)
+	}
+	if b.current == nil {
+		bt := &batch{}
+		bt.timer = time.AfterFunc(b.window, func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			b.flush(bt)
+		})
+		b.current = bt
+	}
+	bt := b.current
+	bt.items = append(bt.items, item)
+	bt.tokens += tokens
+	if (b.maxSize > 0 && len(bt.items) >= b.maxSize) || (b.maxTokens > 0 && bt.tokens >= b.maxTokens) {
+		b.flush(bt)
+	}
+	b.mu.Unlock()
+
+	<-item.done
+	if item.panicValue != nil {
+		panic(item.panicValue)
+	}
+	return item.result
+}
+
+// flush starts computing the given batch, unless it is no longer the
+// current one (that is, it was already flushed). It must be called with
+// mu held.
+func (b *batcher) flush(bt *batch) {
+	if b.current != bt {
+		return
+	}
+	b.current = nil
+	bt.timer.Stop()
+	go b.compute(bt)
+}
+
+// compute computes a batch, then notifies its submitters.
+func (b *batcher) compute(bt *batch) {
+	defer func() {
+		r := recover()
+		for _, item := range bt.items {
+			item.panicValue = r
+			close(item.done)
+		}
+	}()
+
+	inputs := make([]generationInput, len(bt.items))
+	for i, item := range bt.items {
+		inputs[i] = item.input
+	}
+	results := b.run(inputs)
+	for i, item := range bt.items {
+		item.result = results[i]
+	}
+}
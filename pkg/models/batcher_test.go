@@ -0,0 +1,122 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestBatcher returns a batcher whose batches return, for each input, a
+// single hypothesis with the input token IDs and the batch size as score.
+// The sizes of the batches are sent to the returned channel.
+func newTestBatcher(config configuration.Batching) (*batcher, chan int) {
+	sizes := make(chan int, 100)
+	b := newBatcher(config, func(inputs []generationInput) [][]hypothesis {
+		sizes <- len(inputs)
+		results := make([][]hypothesis, len(inputs))
+		for i, in := range inputs {
+			results[i] = []hypothesis{{tokenIDs: in.inputIDs, score: float64(len(inputs))}}
+		}
+		return results
+	})
+	return b, sizes
+}
+
+// submitConcurrently submits the given inputs concurrently, returning the
+// results in the same order.
+func submitConcurrently(b *batcher, inputs ...[]int) [][]hypothesis {
+	results := make([][]hypothesis, len(inputs))
+	var wg sync.WaitGroup
+	for i, ids := range inputs {
+		wg.Add(1)
+		go func(i int, ids []int) {
+			defer wg.Done()
+			results[i] = b.submit(generationInput{inputIDs: ids, n: 1})
+		}(i, ids)
+	}
+	wg.Wait()
+	return results
+}
+
+func TestBatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled with zero window", func(t *testing.T) {
+		t.Parallel()
+		b, _ := newTestBatcher(configuration.Batching{MaxBatchSize: 4})
+		assert.Nil(t, b)
+	})
+
+	t.Run("requests within the window are batched", func(t *testing.T) {
+		t.Parallel()
+		b, sizes := newTestBatcher(configuration.Batching{Window: time.Second, MaxBatchSize: 3})
+
+		start := time.Now()
+		results := submitConcurrently(b, []int{1}, []int{2}, []int{3})
+		assert.Less(t, int64(time.Since(start)), int64(time.Second), "a full batch must not wait for the window")
+
+		assert.Equal(t, 3, <-sizes)
+		for i, r := range results {
+			require.Len(t, r, 1)
+			assert.Equal(t, []int{i + 1}, r[0].tokenIDs)
+			assert.Equal(t, 3.0, r[0].score)
+		}
+	})
+
+	t.Run("a partial batch is computed when the window expires", func(t *testing.T) {
+		t.Parallel()
+		b, sizes := newTestBatcher(configuration.Batching{Window: 10 * time.Millisecond})
+
+		results := submitConcurrently(b, []int{1})
+		assert.Equal(t, 1, <-sizes)
+		assert.Equal(t, []int{1}, results[0][0].tokenIDs)
+	})
+
+	t.Run("the token budget limits the batch", func(t *testing.T) {
+		t.Parallel()
+		b, sizes := newTestBatcher(configuration.Batching{Window: 50 * time.Millisecond, MaxBatchTokens: 4})
+
+		results := submitConcurrently(b, []int{1, 1, 1}, []int{2, 2, 2})
+		assert.Equal(t, 1, <-sizes)
+		assert.Equal(t, 1, <-sizes)
+		assert.Equal(t, []int{1, 1, 1}, results[0][0].tokenIDs)
+		assert.Equal(t, []int{2, 2, 2}, results[1][0].tokenIDs)
+	})
+
+	t.Run("panics are propagated to all the submitters", func(t *testing.T) {
+		t.Parallel()
+		b := newBatcher(configuration.Batching{Window: time.Second, MaxBatchSize: 2}, func([]generationInput) [][]hypothesis {
+			panic("boom")
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.PanicsWithValue(t, "boom", func() {
+					b.submit(generationInput{inputIDs: []int{1}})
+				})
+			}()
+		}
+		wg.Wait()
+	})
+}
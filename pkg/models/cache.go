@@ -0,0 +1,178 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/SpecializedGeneralist/translator/pkg/metrics"
+	"golang.org/x/text/unicode/norm"
+)
+
+// CacheKey identifies a cached translation.
+type CacheKey struct {
+	// Source is the source language.
+	Source string
+	// Target is the target language.
+	Target string
+	// Models identifies the models of the route which performed the
+	// translation, together with their effective generation parameters
+	// (see CacheModels).
+	Models string
+	// NumAlternatives is the number of requested alternatives.
+	NumAlternatives int
+	// GlossaryRevision is the revision of the glossaries (see
+	// Glossaries.Revision).
+	GlossaryRevision uint64
+	// DoNotTranslate are the terms to keep verbatim (see
+	// TranslateOptions), joined by NUL characters.
+	DoNotTranslate string
+	// Text is the normalized input text (see NormalizeCacheText).
+	Text string
+}
+
+// CacheModels returns the identity of the models of a route, for the
+// purpose of a CacheKey: the name and the version of each model, together
+// with its generation parameters from the language model configuration,
+// overridden by the given ones from the request.
+//
+// It also reports whether sampling is enabled for any model of the route,
+// in which case the translation must not be cached, since each sampled
+// translation is expected to be different.
+func CacheModels(route []LanguagePair, params configuration.GenerationParams) (models string, sampling bool) {
+	ids := make([]string, len(route))
+	for i, pair := range route {
+		effective := pair.Model.GenerationParams().Override(params)
+		if effective.Temperature > 0 {
+			sampling = true
+		}
+		ids[i] = fmt.Sprintf("%s@%s%+v", pair.Model.Name(), pair.Model.Version(), effective)
+	}
+	return strings.Join(ids, "|"), sampling
+}
+
+// NormalizeCacheText normalizes a text for the purpose of a CacheKey.
+//
+// The text is converted to Unicode NFC form, and leading and trailing
+// white space is removed; the latter is returned separately, so that it
+// can be restored around the translated text.
+func NormalizeCacheText(text string) (normalized, leading, trailing string) {
+	trimmed := strings.TrimSpace(text)
+	start := strings.Index(text, trimmed)
+	leading = text[:start]
+	trailing = text[start+len(trimmed):]
+	return norm.NFC.String(trimmed), leading, trailing
+}
+
+// Cache is an in-memory, size- and TTL-bounded LRU cache of translations.
+// It is safe for concurrent use.
+type Cache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ttl        time.Duration
+	ll         *list.List
+	items      map[CacheKey]*list.Element
+}
+
+type cacheEntry struct {
+	key          CacheKey
+	translations []Translation
+	expiresAt    time.Time
+}
+
+// NewCache creates a new Cache holding up to maxEntries translations.
+// Entries expire after the given ttl; zero means no expiration.
+func NewCache(maxEntries int, ttl time.Duration) *Cache {
+	return &Cache{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		ll:         list.New(),
+		items:      make(map[CacheKey]*list.Element),
+	}
+}
+
+// Get looks up the translations for the given key, marking them as
+// recently used. Expired entries are removed and reported as missing.
+func (c *Cache) Get(key CacheKey) ([]Translation, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if ok {
+		e := el.Value.(*cacheEntry)
+		if c.isExpired(e) {
+			c.removeElement(el)
+		} else {
+			c.ll.MoveToFront(el)
+			metrics.CacheHits.Inc()
+			return e.translations, true
+		}
+	}
+	metrics.CacheMisses.Inc()
+	return nil, false
+}
+
+// Add adds or replaces the translations for the given key, evicting the
+// least recently used entry if the cache is full.
+func (c *Cache) Add(key CacheKey, translations []Translation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expiresAt time.Time
+	if c.ttl > 0 {
+		expiresAt = time.Now().Add(c.ttl)
+	}
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		e := el.Value.(*cacheEntry)
+		e.translations = translations
+		e.expiresAt = expiresAt
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&cacheEntry{
+		key:          key,
+		translations: translations,
+		expiresAt:    expiresAt,
+	})
+	if c.ll.Len() > c.maxEntries {
+		c.removeElement(c.ll.Back())
+	}
+	metrics.CacheEntries.Set(float64(c.ll.Len()))
+}
+
+// Len returns the number of entries in the cache, including the expired
+// ones which have not been removed yet.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *Cache) isExpired(e *cacheEntry) bool {
+	return !e.expiresAt.IsZero() && !time.Now().Before(e.expiresAt)
+}
+
+func (c *Cache) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*cacheEntry).key)
+	metrics.CacheEntries.Set(float64(c.ll.Len()))
+}
@@ -0,0 +1,128 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	key := func(text string) models.CacheKey {
+		return models.CacheKey{Source: "it", Target: "en", Models: "foo", Text: text}
+	}
+	translations := func(text string) []models.Translation {
+		return []models.Translation{{Text: text, Score: -0.5}}
+	}
+
+	t.Run("get and add", func(t *testing.T) {
+		t.Parallel()
+		c := models.NewCache(10, 0)
+
+		_, ok := c.Get(key("Ciao"))
+		assert.False(t, ok)
+
+		c.Add(key("Ciao"), translations("Hello"))
+		v, ok := c.Get(key("Ciao"))
+		assert.True(t, ok)
+		assert.Equal(t, translations("Hello"), v)
+
+		other := key("Ciao")
+		other.NumAlternatives = 2
+		_, ok = c.Get(other)
+		assert.False(t, ok)
+	})
+
+	t.Run("least recently used entries are evicted", func(t *testing.T) {
+		t.Parallel()
+		c := models.NewCache(2, 0)
+
+		c.Add(key("a"), translations("A"))
+		c.Add(key("b"), translations("B"))
+		_, _ = c.Get(key("a"))
+		c.Add(key("c"), translations("C"))
+
+		assert.Equal(t, 2, c.Len())
+		_, ok := c.Get(key("b"))
+		assert.False(t, ok)
+		_, ok = c.Get(key("a"))
+		assert.True(t, ok)
+		_, ok = c.Get(key("c"))
+		assert.True(t, ok)
+	})
+
+	t.Run("expired entries are removed", func(t *testing.T) {
+		t.Parallel()
+		c := models.NewCache(10, 10*time.Millisecond)
+
+		c.Add(key("a"), translations("A"))
+		time.Sleep(20 * time.Millisecond)
+
+		_, ok := c.Get(key("a"))
+		assert.False(t, ok)
+		assert.Equal(t, 0, c.Len())
+	})
+}
+
+func TestNormalizeCacheText(t *testing.T) {
+	t.Parallel()
+
+	normalized, leading, trailing := models.NormalizeCacheText(" \n Cafe\u0301 \t")
+	assert.Equal(t, "Caf\u00e9", normalized)
+	assert.Equal(t, " \n ", leading)
+	assert.Equal(t, " \t", trailing)
+}
+
+func TestCacheModels(t *testing.T) {
+	t.Parallel()
+
+	config := &configuration.Config{}
+	newRoute := func(lms ...configuration.LanguageModel) []models.LanguagePair {
+		route := make([]models.LanguagePair, len(lms))
+		for i, lm := range lms {
+			route[i] = models.LanguagePair{Source: lm.Source, Target: lm.Target, Model: models.NewModel(config, lm, zerolog.Nop())}
+		}
+		return route
+	}
+
+	enIt := configuration.LanguageModel{Source: "en", Target: "it", Model: "en-it", Version: "1"}
+	ids, sampling := models.CacheModels(newRoute(enIt), configuration.GenerationParams{})
+	assert.False(t, sampling)
+
+	enIt2 := enIt
+	enIt2.Version = "2"
+	ids2, _ := models.CacheModels(newRoute(enIt2), configuration.GenerationParams{})
+	assert.NotEqual(t, ids, ids2, "the model version must be part of the identity")
+
+	beams := enIt
+	beams.Generation = configuration.GenerationParams{NumBeams: 4}
+	ids3, _ := models.CacheModels(newRoute(beams), configuration.GenerationParams{})
+	assert.NotEqual(t, ids, ids3, "the model generation parameters must be part of the identity")
+	ids4, _ := models.CacheModels(newRoute(enIt), configuration.GenerationParams{NumBeams: 4})
+	assert.Equal(t, ids3, ids4, "the effective generation parameters must be compared")
+
+	sampled := configuration.LanguageModel{Source: "it", Target: "fr", Model: "it-fr"}
+	sampled.Generation.Temperature = 0.7
+	_, sampling = models.CacheModels(newRoute(enIt, sampled), configuration.GenerationParams{})
+	assert.True(t, sampling, "sampling configured for a model of the route must be detected")
+	_, sampling = models.CacheModels(newRoute(enIt), configuration.GenerationParams{Temperature: 1})
+	assert.True(t, sampling)
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+)
+
+// entityRegexps are the regular expressions matching each kind of
+// protected entity (see configuration.EntityProtection).
+var entityRegexps = map[string]*regexp.Regexp{
+	"url": regexp.MustCompile(
+		`(?i)\b(?:https?://|ftp://|www\.)[^\s<>"]*[^\s<>"'.,;:!?)\]}]`),
+	"email": regexp.MustCompile(
+		`[\p{L}\p{N}._%+-]+@[\p{L}\p{N}-]+(?:\.[\p{L}\p{N}-]+)*\.\p{L}{2,}`),
+	"hashtag": regexp.MustCompile(
+		`#[\p{L}\p{N}_]*\p{L}[\p{L}\p{N}_]*`),
+	"mention": regexp.MustCompile(
+		`@[\p{L}\p{N}_](?:[\p{L}\p{N}_.]*[\p{L}\p{N}_])?`),
+	"number": regexp.MustCompile(
+		`[$€£¥]\s?\d+(?:[.,']\d+)*` +
+			`|\d+(?:[.,']\d+)*\s?(?:%|‰|°[CF]?|[$€£¥]|` +
+			`(?:[kMGTP]i?B|[kMG]?Hz|km/h|m/s|mph|kWh|mAh|[kcmµn]?m|[kmµ]?g|m?l|[mµn]?s|px|pt|dpi|r?em|[kMG]?W)\b)`),
+	"code": regexp.MustCompile(
+		`[A-Za-z_]\w*(?:(?:\.|::|->)[A-Za-z_]\w*)*\(\)` +
+			`|\w*[A-Za-z0-9]_\w*|_\w+` +
+			`|[a-z][a-z0-9]*[A-Z]\w*` +
+			`|[A-Z][a-z0-9]+[A-Z]\w*` +
+			`|[\w-]+\.(?:go|py|js|ts|json|ya?ml|toml|md|txt|html?|css|java|c|h|cpp|rs|rb|sh|xml|csv|pdf)\b`),
+}
+
+// entityKinds are the kinds of protected entities, in order of precedence.
+var entityKinds = []string{"url", "email", "hashtag", "mention", "number", "code"}
+
+// entityDetector finds the entities which must not be translated.
+type entityDetector struct {
+	regexps []*regexp.Regexp
+}
+
+// newEntityDetector creates a new entityDetector according to the
+// configuration. It returns nil if entity protection is disabled.
+func newEntityDetector(config configuration.EntityProtection) (*entityDetector, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+	kinds := config.Entities
+	if len(kinds) == 0 {
+		kinds = entityKinds
+	}
+	d := &entityDetector{regexps: make([]*regexp.Regexp, 0, len(kinds))}
+	for _, kind := range kinds {
+		re, ok := entityRegexps[kind]
+		if !ok {
+			return nil, fmt.Errorf("invalid entity protection kind %#v", kind)
+		}
+		d.regexps = append(d.regexps, re)
+	}
+	return d, nil
+}
+
+// spans returns the spans of the entities found in the text, which are
+// restored verbatim. Entities are only matched if they are not preceded or
+// followed by word characters. A nil entityDetector finds nothing.
+func (d *entityDetector) spans(text string) []maskSpan {
+	if d == nil {
+		return nil
+	}
+	var spans []maskSpan
+	for _, re := range d.regexps {
+		for _, loc := range re.FindAllStringIndex(text, -1) {
+			start, end := loc[0], loc[1]
+			if start > 0 {
+				if r, _ := utf8.DecodeLastRuneInString(text[:start]); isWordChar(r) {
+					continue
+				}
+			}
+			if !isWordBoundary(text, end) {
+				continue
+			}
+			spans = append(spans, maskSpan{start: start, end: end, replacement: text[start:end]})
+		}
+	}
+	return spans
+}
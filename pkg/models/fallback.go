@@ -0,0 +1,102 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+)
+
+// Fallback describes what to do when a translation fails, according to
+// the configuration of the fallbacks of its language pair.
+type Fallback struct {
+	// Timeout is the deadline of each translation attempt. Zero means no
+	// deadline.
+	Timeout time.Duration
+	// Routes are the alternative routes to try, in order: first the
+	// alternate models of the pair, then a route through intermediate
+	// languages, if configured and available.
+	Routes [][]LanguagePair
+	// Untranslated reports whether the source text must be returned
+	// untranslated, if all the routes fail.
+	Untranslated bool
+}
+
+// Fallback returns the Fallback for the translations along the given route
+// (see Route), according to the configured fallbacks for the language pair
+// from its first source to its last target. It reports false if no
+// fallback is configured.
+//
+// The alternate models which are already part of the route are skipped, and
+// so is the pivot route, if the route already goes through intermediate
+// languages. The models of the pivot route are chosen by weight (see
+// SelectModels).
+func (mng *Manager) Fallback(route []LanguagePair) (Fallback, bool) {
+	mng.mu.RLock()
+	defer mng.mu.RUnlock()
+
+	source, target := route[0].Source, route[len(route)-1].Target
+	for _, fc := range mng.config.Fallbacks {
+		r, err := mng.route(fc.Source, fc.Target)
+		if err != nil || r[0].Source != source || r[len(r)-1].Target != target {
+			continue
+		}
+
+		fallback := Fallback{Timeout: fc.Timeout, Untranslated: fc.Untranslated}
+		if len(route) == 1 {
+			pairModels := mng.models[source][target]
+			for _, name := range fc.Models {
+				model := findModel(pairModels, name)
+				if model == nil || model == route[0].Model {
+					continue
+				}
+				pair := newLanguagePair(source, target, pairModels)
+				pair.Model = model
+				fallback.Routes = append(fallback.Routes, []LanguagePair{pair})
+			}
+			if fc.Pivot {
+				max := mng.config.PivotTranslation.MaxIntermediateLanguages
+				if pivot := findRoute(mng.models, source, target, max, true); pivot != nil {
+					pivot, _ = SelectModels(pivot, "")
+					fallback.Routes = append(fallback.Routes, pivot)
+				}
+			}
+		}
+		return fallback, true
+	}
+	return Fallback{}, false
+}
+
+// checkFallbacks returns an error if the alternate models of the given
+// fallbacks are not configured for their language pairs.
+func checkFallbacks(fallbacks []configuration.Fallback, models modelsMap, aliases languageAliases) error {
+	for _, fc := range fallbacks {
+		if len(fc.Models) == 0 {
+			continue
+		}
+		pair, ok := findPair(models, aliases, fc.Source, fc.Target)
+		if !ok {
+			return fmt.Errorf("invalid fallback models: no model for translation from %#v to %#v", fc.Source, fc.Target)
+		}
+		for _, name := range fc.Models {
+			if _, ok := pair.modelNamed(name); !ok {
+				return fmt.Errorf("invalid fallback model %#v: it is not configured for translation from %#v to %#v", name, fc.Source, fc.Target)
+			}
+		}
+	}
+	return nil
+}
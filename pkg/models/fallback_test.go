@@ -0,0 +1,71 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagerFallback(t *testing.T) {
+	t.Parallel()
+
+	config := &configuration.Config{
+		LazyLoading: configuration.LazyLoading{Enabled: true},
+		Fallbacks: []configuration.Fallback{
+			{
+				Source:       "en",
+				Target:       "it",
+				Timeout:      time.Second,
+				Models:       []string{"en-it", "en-it-candidate"},
+				Pivot:        true,
+				Untranslated: true,
+			},
+		},
+		LanguageModels: []configuration.LanguageModel{
+			{Source: "en", Target: "it", Model: "en-it"},
+			{Source: "en", Target: "it", Model: "en-it-candidate"},
+			{Source: "en", Target: "fr", Model: "en-fr"},
+			{Source: "fr", Target: "it", Model: "fr-it"},
+		},
+	}
+	mng := NewManager(config, zerolog.Nop())
+	require.NoError(t, mng.LoadModels())
+
+	route, err := mng.Route("en", "it")
+	require.NoError(t, err)
+
+	fallback, ok := mng.Fallback(route)
+	require.True(t, ok)
+	assert.Equal(t, time.Second, fallback.Timeout)
+	assert.True(t, fallback.Untranslated)
+	require.Len(t, fallback.Routes, 2)
+	assert.Equal(t, []string{"en-it-candidate"}, routeModels(fallback.Routes[0]))
+	assert.Equal(t, []string{"en-fr", "fr-it"}, routeModels(fallback.Routes[1]))
+
+	route, err = mng.Route("en", "fr")
+	require.NoError(t, err)
+	_, ok = mng.Fallback(route)
+	assert.False(t, ok)
+
+	invalid := *config
+	invalid.Fallbacks = []configuration.Fallback{{Source: "en", Target: "it", Models: []string{"unknown"}}}
+	assert.Error(t, mng.Reload(&invalid))
+}
@@ -0,0 +1,616 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/nlpodyssey/spago/pkg/ml/ag"
+	bartconfig "github.com/nlpodyssey/spago/pkg/nlp/transformers/bart/config"
+	"github.com/nlpodyssey/spago/pkg/nlp/transformers/generation"
+)
+
+// generator performs text generation (decoding) with an encoder-decoder
+// model, either with beam search or with sampling.
+//
+// Unlike spaGO generation.Generator, it supports all the parameters
+// described by configuration.GenerationParams.
+type generator struct {
+	model  generation.EncoderDecoder
+	config bartconfig.Config
+	params configuration.GenerationParams
+	rand   *rand.Rand
+}
+
+// hypothesis is a generated sequence of token IDs, together with its score:
+// the sum of the log-probabilities of the tokens, normalized according to
+// the length penalty.
+type hypothesis struct {
+	tokenIDs []int
+	score    float64
+}
+
+// beam is an in-progress generation sequence.
+type beam struct {
+	tokenIDs []int
+	// sumLogProbs is the sum of the log-probabilities of the tokens.
+	sumLogProbs float64
+	cache       generation.Cache
+}
+
+// defaultGenerationParams returns the generation parameters defined by the
+// configuration of a BART model.
+func defaultGenerationParams(config bartconfig.Config) configuration.GenerationParams {
+	return configuration.GenerationParams{
+		NumBeams:      config.NumBeams,
+		MaxLength:     config.MaxLength,
+		LengthPenalty: 1,
+	}
+}
+
+func newGenerator(model generation.EncoderDecoder, config bartconfig.Config, params configuration.GenerationParams, rnd *rand.Rand) *generator {
+	if params.NumBeams < 1 {
+		params.NumBeams = 1
+	}
+	if params.MaxLength < 1 {
+		params.MaxLength = config.MaxPositionEmbeddings
+	}
+	return &generator{
+		model:  model,
+		config: config,
+		params: params,
+		rand:   rnd,
+	}
+}
+
+// generate returns up to n best generated hypotheses, sorted by
+// descending score.
+//
+// Beam search returns at most NumBeams hypotheses. Sampling draws n
+// sequences, returning only the distinct ones.
+func (gen *generator) generate(inputIDs []int, n int) []hypothesis {
+	return generateBatch([]generationRequest{{generator: gen, inputIDs: inputIDs, n: n}})[0]
+}
+
+// generationRequest is a single input of generateBatch.
+type generationRequest struct {
+	generator *generator
+	inputIDs  []int
+	// n is the maximum amount of hypotheses, as for generator.generate.
+	n int
+}
+
+// generateBatch performs the generation for several inputs at once,
+// returning the hypotheses of each request, as for generator.generate.
+//
+// The generators, which can have different parameters, must share the same
+// model graph: all the inputs are encoded with a single forward pass, and
+// each decoding step of all the in-progress searches is computed with a
+// single forward pass as well.
+func generateBatch(requests []generationRequest) [][]hypothesis {
+	model := requests[0].generator.model
+
+	encoded := make([][]ag.Node, len(requests))
+	for i, r := range requests {
+		encoded[i] = model.Encode(r.inputIDs)
+	}
+	requests[0].generator.forward()
+
+	searches := make([]search, len(requests))
+	for i, r := range requests {
+		searches[i] = r.generator.newSearch(r.n)
+	}
+
+	beams := make([][]*beam, len(searches))
+	for {
+		var nodes []ag.Node
+		var caches []generation.Cache
+		for i, s := range searches {
+			beams[i] = s.pending()
+			for _, b := range beams[i] {
+				node, cache := model.Decode(encoded[i], b.tokenIDs, b.cache)
+				nodes = append(nodes, node)
+				caches = append(caches, cache)
+			}
+		}
+		if len(nodes) == 0 {
+			break
+		}
+		requests[0].generator.forward()
+
+		logits := nodeLogits(model.Graph(), nodes)
+		offset := 0
+		for i, s := range searches {
+			if len(beams[i]) == 0 {
+				continue
+			}
+			end := offset + len(beams[i])
+			s.step(logits[offset:end], caches[offset:end])
+			offset = end
+		}
+	}
+
+	results := make([][]hypothesis, len(searches))
+	for i, s := range searches {
+		results[i] = s.result()
+	}
+	return results
+}
+
+// search is the state of the generation for a single input, advanced one
+// decoding step at a time, so that the steps of several searches can share
+// the same forward passes (see generateBatch).
+type search interface {
+	// pending returns the beams whose next token logits are required by
+	// the next step, or nil if the search is over.
+	pending() []*beam
+	// step advances the search, given the next token logits and the new
+	// decoding caches of the beams returned by pending.
+	step(logits [][]float64, caches []generation.Cache)
+	// result returns the generated hypotheses, once the search is over.
+	result() []hypothesis
+}
+
+// newSearch returns a new search for up to n hypotheses, either with
+// sampling or with beam search.
+func (gen *generator) newSearch(n int) search {
+	if gen.params.Temperature > 0 {
+		return newSampleSearch(gen, n)
+	}
+	return newBeamSearch(gen, n)
+}
+
+// beamSearch is a search with beam search.
+type beamSearch struct {
+	gen      *generator
+	n        int
+	beams    []*beam
+	finished *hypotheses
+	done     bool
+}
+
+func newBeamSearch(gen *generator, n int) *beamSearch {
+	s := &beamSearch{
+		gen:      gen,
+		n:        n,
+		beams:    []*beam{{tokenIDs: []int{gen.config.DecoderStartTokenID}}},
+		finished: newHypotheses(gen.params.NumBeams, gen.params.LengthPenalty),
+	}
+	s.checkMaxLength()
+	return s
+}
+
+func (s *beamSearch) pending() []*beam {
+	if s.done {
+		return nil
+	}
+	return s.beams
+}
+
+func (s *beamSearch) step(logits [][]float64, caches []generation.Cache) {
+	numBeams := s.gen.params.NumBeams
+	eosTokenID := s.gen.config.EosTokenID
+
+	candidates := make([]beamCandidate, 0, len(s.beams)*numBeams*2)
+	for i, b := range s.beams {
+		logProbs := logSoftmax(s.gen.maskLogits(logits[i], b.tokenIDs))
+		for _, tokenID := range topKIndices(logProbs, numBeams*2) {
+			candidates = append(candidates, beamCandidate{
+				beamIndex:   i,
+				tokenID:     tokenID,
+				sumLogProbs: b.sumLogProbs + logProbs[tokenID],
+			})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].sumLogProbs > candidates[j].sumLogProbs
+	})
+
+	nextBeams := make([]*beam, 0, numBeams)
+	for rank, c := range candidates {
+		prev := s.beams[c.beamIndex]
+		if c.tokenID == eosTokenID {
+			// An EOS token not belonging to the top numBeams candidates
+			// is not a good enough hypothesis.
+			if rank < numBeams {
+				s.finished.add(prev.tokenIDs, c.sumLogProbs)
+			}
+			continue
+		}
+		nextBeams = append(nextBeams, &beam{
+			tokenIDs:    appendToken(prev.tokenIDs, c.tokenID),
+			sumLogProbs: c.sumLogProbs,
+			cache:       caches[c.beamIndex],
+		})
+		if len(nextBeams) == numBeams {
+			break
+		}
+	}
+
+	// As in spaGO generation.Generator, the search is over if even the
+	// best candidate, EOS included, at the current length cannot improve
+	// the finished hypotheses.
+	if len(nextBeams) == 0 || s.finished.isDone(candidates[0].sumLogProbs, len(s.beams[0].tokenIDs)) {
+		s.done = true
+		return
+	}
+	s.beams = nextBeams
+	s.checkMaxLength()
+}
+
+// checkMaxLength terminates the search, adding the in-progress beams to
+// the finished hypotheses, once they reach the maximum length.
+func (s *beamSearch) checkMaxLength() {
+	if len(s.beams[0].tokenIDs) < s.gen.params.MaxLength {
+		return
+	}
+	for _, b := range s.beams {
+		s.finished.add(b.tokenIDs, b.sumLogProbs)
+	}
+	s.done = true
+}
+
+func (s *beamSearch) result() []hypothesis {
+	hyps := s.finished.sorted()
+	if len(hyps) > s.n {
+		hyps = hyps[:s.n]
+	}
+	return hyps
+}
+
+type beamCandidate struct {
+	beamIndex   int
+	tokenID     int
+	sumLogProbs float64
+}
+
+// sampleSearch is a search drawing n sequences with sampling.
+type sampleSearch struct {
+	gen     *generator
+	samples []*beam
+	done    []bool
+	// active are the indices of the samples returned by pending.
+	active []int
+}
+
+func newSampleSearch(gen *generator, n int) *sampleSearch {
+	s := &sampleSearch{
+		gen:     gen,
+		samples: make([]*beam, n),
+		done:    make([]bool, n),
+	}
+	for i := range s.samples {
+		s.samples[i] = &beam{tokenIDs: []int{gen.config.DecoderStartTokenID}}
+	}
+	return s
+}
+
+func (s *sampleSearch) pending() []*beam {
+	s.active = s.active[:0]
+	beams := make([]*beam, 0, len(s.samples))
+	for i, b := range s.samples {
+		if !s.done[i] && len(b.tokenIDs) < s.gen.params.MaxLength {
+			s.active = append(s.active, i)
+			beams = append(beams, b)
+		}
+	}
+	if len(beams) == 0 {
+		return nil
+	}
+	return beams
+}
+
+func (s *sampleSearch) step(logits [][]float64, caches []generation.Cache) {
+	for i, index := range s.active {
+		b := s.samples[index]
+		maskedLogits := s.gen.maskLogits(logits[i], b.tokenIDs)
+
+		tokenID := s.gen.sampleToken(maskedLogits)
+		b.sumLogProbs += logSoftmax(maskedLogits)[tokenID]
+		if tokenID == s.gen.config.EosTokenID {
+			s.done[index] = true
+			continue
+		}
+		b.tokenIDs = appendToken(b.tokenIDs, tokenID)
+		b.cache = caches[i]
+	}
+}
+
+// result returns the distinct samples.
+func (s *sampleSearch) result() []hypothesis {
+	hyps := make([]hypothesis, 0, len(s.samples))
+	for _, b := range s.samples {
+		hyp := hypothesis{
+			tokenIDs: b.tokenIDs,
+			score:    normalizeScore(b.sumLogProbs, len(b.tokenIDs), s.gen.params.LengthPenalty),
+		}
+		if !containsHypothesis(hyps, hyp) {
+			hyps = append(hyps, hyp)
+		}
+	}
+	sort.SliceStable(hyps, func(i, j int) bool {
+		return hyps[i].score > hyps[j].score
+	})
+	return hyps
+}
+
+func containsHypothesis(hyps []hypothesis, hyp hypothesis) bool {
+	for _, h := range hyps {
+		if intsEqual(h.tokenIDs, hyp.tokenIDs) {
+			return true
+		}
+	}
+	return false
+}
+
+// sampleToken samples the next token ID from the given logits, according
+// to temperature, top-k and top-p parameters.
+func (gen *generator) sampleToken(logits []float64) int {
+	scaled := make([]float64, len(logits))
+	for i, v := range logits {
+		scaled[i] = v / gen.params.Temperature
+	}
+	probs := softmax(scaled)
+
+	indices := make([]int, 0, len(probs))
+	for i, p := range probs {
+		if p > 0 {
+			indices = append(indices, i)
+		}
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return probs[indices[i]] > probs[indices[j]]
+	})
+
+	if k := gen.params.TopK; k > 0 && k < len(indices) {
+		indices = indices[:k]
+	}
+	if p := gen.params.TopP; p > 0 && p < 1 {
+		cumulative := 0.0
+		for i, index := range indices {
+			cumulative += probs[index]
+			if cumulative >= p {
+				indices = indices[:i+1]
+				break
+			}
+		}
+	}
+
+	total := 0.0
+	for _, index := range indices {
+		total += probs[index]
+	}
+	r := gen.rand.Float64() * total
+	for _, index := range indices {
+		r -= probs[index]
+		if r < 0 {
+			return index
+		}
+	}
+	return indices[len(indices)-1]
+}
+
+// nodeLogits returns the computed values of the given logits nodes.
+func nodeLogits(g *ag.Graph, nodes []ag.Node) [][]float64 {
+	logits := make([][]float64, len(nodes))
+	for i, node := range nodes {
+		data := g.GetCopiedValue(node).Data()
+		logits[i] = make([]float64, len(data))
+		for j, v := range data {
+			logits[i][j] = float64(v)
+		}
+	}
+	return logits
+}
+
+func (gen *generator) forward() {
+	g := gen.model.Graph()
+	g.Forward(ag.Range(g.TimeStep(), -1))
+	g.IncTimeStep() // mark the next block to be computed from here on
+}
+
+// maskLogits sets to -Inf the logits of the tokens which cannot be
+// generated after the given sequence. The logits are modified in place
+// and returned.
+func (gen *generator) maskLogits(logits []float64, tokenIDs []int) []float64 {
+	negInf := math.Inf(-1)
+	config := gen.config
+
+	if config.PadTokenID >= 0 && config.PadTokenID < len(logits) {
+		logits[config.PadTokenID] = negInf
+	}
+
+	for _, badWord := range config.BadWordsIDs {
+		if len(badWord) == 0 || (len(badWord) == 1 && badWord[0] == config.EosTokenID) {
+			continue
+		}
+		last := len(badWord) - 1
+		if hasSuffix(tokenIDs, badWord[:last]) {
+			logits[badWord[last]] = negInf
+		}
+	}
+
+	if n := gen.params.NoRepeatNGramSize; n > 0 {
+		for _, tokenID := range bannedNGramTokens(tokenIDs, n) {
+			logits[tokenID] = negInf
+		}
+	}
+
+	if len(tokenIDs) == gen.params.MaxLength-1 || allNegInf(logits) {
+		// Force EOS to be generated
+		for i := range logits {
+			if i != config.EosTokenID {
+				logits[i] = negInf
+			}
+		}
+		logits[config.EosTokenID] = 0
+	}
+
+	return logits
+}
+
+// bannedNGramTokens returns the tokens which, appended to the sequence,
+// would repeat an n-gram already present in the sequence.
+func bannedNGramTokens(tokenIDs []int, n int) []int {
+	if len(tokenIDs)+1 < n {
+		return nil
+	}
+	prefix := tokenIDs[len(tokenIDs)-n+1:]
+	banned := make([]int, 0)
+	for i := 0; i+n <= len(tokenIDs); i++ {
+		if intsEqual(tokenIDs[i:i+n-1], prefix) {
+			banned = append(banned, tokenIDs[i+n-1])
+		}
+	}
+	return banned
+}
+
+// hypotheses keeps the best n finished hypotheses.
+type hypotheses struct {
+	n             int
+	lengthPenalty float64
+	items         []hypothesis
+}
+
+func newHypotheses(n int, lengthPenalty float64) *hypotheses {
+	return &hypotheses{
+		n:             n,
+		lengthPenalty: lengthPenalty,
+		items:         make([]hypothesis, 0, n+1),
+	}
+}
+
+func (h *hypotheses) add(tokenIDs []int, sumLogProbs float64) {
+	score := normalizeScore(sumLogProbs, len(tokenIDs), h.lengthPenalty)
+	if len(h.items) == h.n && score <= h.worstScore() {
+		return
+	}
+	h.items = append(h.items, hypothesis{
+		tokenIDs: append([]int(nil), tokenIDs...),
+		score:    score,
+	})
+	sort.SliceStable(h.items, func(i, j int) bool {
+		return h.items[i].score > h.items[j].score
+	})
+	if len(h.items) > h.n {
+		h.items = h.items[:h.n]
+	}
+}
+
+func (h *hypotheses) worstScore() float64 {
+	return h.items[len(h.items)-1].score
+}
+
+// isDone reports whether there are enough hypotheses, and none of the
+// in-progress beams can become better than the worst one.
+func (h *hypotheses) isDone(bestSumLogProbs float64, curLen int) bool {
+	if len(h.items) < h.n {
+		return false
+	}
+	return h.worstScore() >= normalizeScore(bestSumLogProbs, curLen, h.lengthPenalty)
+}
+
+func (h *hypotheses) sorted() []hypothesis {
+	return h.items
+}
+
+func normalizeScore(sumLogProbs float64, length int, lengthPenalty float64) float64 {
+	return sumLogProbs / math.Pow(float64(length), lengthPenalty)
+}
+
+func logSoftmax(xs []float64) []float64 {
+	max := math.Inf(-1)
+	for _, x := range xs {
+		if x > max {
+			max = x
+		}
+	}
+	sum := 0.0
+	for _, x := range xs {
+		sum += math.Exp(x - max)
+	}
+	logSum := max + math.Log(sum)
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = x - logSum
+	}
+	return ys
+}
+
+func softmax(xs []float64) []float64 {
+	ys := logSoftmax(xs)
+	for i, y := range ys {
+		ys[i] = math.Exp(y)
+	}
+	return ys
+}
+
+// topKIndices returns the indices of the k greatest finite values,
+// sorted by descending value.
+func topKIndices(xs []float64, k int) []int {
+	indices := make([]int, 0, k+1)
+	for i, x := range xs {
+		if math.IsInf(x, -1) {
+			continue
+		}
+		if len(indices) == k && x <= xs[indices[k-1]] {
+			continue
+		}
+		pos := sort.Search(len(indices), func(j int) bool {
+			return xs[indices[j]] < x
+		})
+		indices = append(indices, 0)
+		copy(indices[pos+1:], indices[pos:])
+		indices[pos] = i
+		if len(indices) > k {
+			indices = indices[:k]
+		}
+	}
+	return indices
+}
+
+func allNegInf(xs []float64) bool {
+	for _, x := range xs {
+		if !math.IsInf(x, -1) {
+			return false
+		}
+	}
+	return true
+}
+
+func appendToken(tokenIDs []int, tokenID int) []int {
+	result := make([]int, len(tokenIDs), len(tokenIDs)+1)
+	copy(result, tokenIDs)
+	return append(result, tokenID)
+}
+
+func hasSuffix(xs, suffix []int) bool {
+	return len(suffix) <= len(xs) && intsEqual(xs[len(xs)-len(suffix):], suffix)
+}
+
+func intsEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,279 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	mat "github.com/nlpodyssey/spago/pkg/mat32"
+	"github.com/nlpodyssey/spago/pkg/ml/ag"
+	bartconfig "github.com/nlpodyssey/spago/pkg/nlp/transformers/bart/config"
+	"github.com/nlpodyssey/spago/pkg/nlp/transformers/generation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Token IDs of the fake vocabulary.
+const (
+	fakePad = iota
+	fakeEOS
+	fakeStart
+	fakeA
+	fakeB
+	fakeC
+	fakeVocabSize
+)
+
+// fakeEncoderDecoder is a generation.EncoderDecoder whose next token
+// logits only depend on the last token of the decoding sequence.
+type fakeEncoderDecoder struct {
+	g      *ag.Graph
+	logits map[int][]mat.Float
+}
+
+func (f *fakeEncoderDecoder) Graph() *ag.Graph {
+	return f.g
+}
+
+func (f *fakeEncoderDecoder) Encode(inputIDs []int) []ag.Node {
+	return []ag.Node{f.g.NewScalar(0)}
+}
+
+func (f *fakeEncoderDecoder) Decode(_ []ag.Node, inputIDs []int, _ generation.Cache) (ag.Node, generation.Cache) {
+	last := inputIDs[len(inputIDs)-1]
+	return f.g.NewVariable(mat.NewVecDense(f.logits[last]), false), nil
+}
+
+func newFakeGenerator(params configuration.GenerationParams) *generator {
+	model := &fakeEncoderDecoder{
+		g: ag.NewGraph(ag.IncrementalForward(false)),
+		logits: map[int][]mat.Float{
+			//            pad  eos  start a  b  c
+			fakeStart: {0, -9, -9, 2, 1.9, -9},
+			fakeA:     {0, -9, -9, 1, -9, 1},
+			fakeB:     {0, 9, -9, -9, -9, -9},
+			fakeC:     {0, 9, -9, -9, -9, -9},
+		},
+	}
+	config := bartconfig.Config{
+		PadTokenID:          fakePad,
+		EosTokenID:          fakeEOS,
+		DecoderStartTokenID: fakeStart,
+		VocabSize:           fakeVocabSize,
+		NumBeams:            1,
+		MaxLength:           5,
+	}
+	params = defaultGenerationParams(config).Override(params)
+	return newGenerator(model, config, params, rand.New(rand.NewSource(42)))
+}
+
+func TestGenerator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("greedy search up to max length", func(t *testing.T) {
+		t.Parallel()
+		hyps := newFakeGenerator(configuration.GenerationParams{}).generate([]int{1}, 1)
+		assert.Len(t, hyps, 1)
+		assert.Equal(t, []int{fakeStart, fakeA, fakeA, fakeA}, hyps[0].tokenIDs)
+	})
+
+	t.Run("beam search finds the better hypothesis", func(t *testing.T) {
+		t.Parallel()
+		hyps := newFakeGenerator(configuration.GenerationParams{NumBeams: 2}).generate([]int{1}, 2)
+		assert.Len(t, hyps, 2)
+		assert.Equal(t, []int{fakeStart, fakeB}, hyps[0].tokenIDs)
+		assert.Greater(t, hyps[0].score, hyps[1].score)
+	})
+
+	t.Run("beam search returns at most n hypotheses", func(t *testing.T) {
+		t.Parallel()
+		hyps := newFakeGenerator(configuration.GenerationParams{NumBeams: 2}).generate([]int{1}, 1)
+		assert.Len(t, hyps, 1)
+		assert.Equal(t, []int{fakeStart, fakeB}, hyps[0].tokenIDs)
+	})
+
+	t.Run("max length override", func(t *testing.T) {
+		t.Parallel()
+		hyps := newFakeGenerator(configuration.GenerationParams{MaxLength: 3}).generate([]int{1}, 1)
+		assert.Equal(t, []int{fakeStart, fakeA}, hyps[0].tokenIDs)
+	})
+
+	t.Run("no repeat n-grams", func(t *testing.T) {
+		t.Parallel()
+		hyps := newFakeGenerator(configuration.GenerationParams{NoRepeatNGramSize: 2}).generate([]int{1}, 1)
+		assert.Equal(t, []int{fakeStart, fakeA, fakeA, fakeC}, hyps[0].tokenIDs)
+	})
+
+	t.Run("sampling with top-k 1 is greedy", func(t *testing.T) {
+		t.Parallel()
+		hyps := newFakeGenerator(configuration.GenerationParams{Temperature: 0.5, TopK: 1}).generate([]int{1}, 1)
+		assert.Len(t, hyps, 1)
+		assert.Equal(t, []int{fakeStart, fakeA, fakeA, fakeA}, hyps[0].tokenIDs)
+	})
+
+	t.Run("sampling returns distinct hypotheses", func(t *testing.T) {
+		t.Parallel()
+		hyps := newFakeGenerator(configuration.GenerationParams{Temperature: 1, TopK: 1}).generate([]int{1}, 3)
+		assert.Len(t, hyps, 1)
+	})
+}
+
+func TestGenerateBatch(t *testing.T) {
+	t.Parallel()
+
+	greedy := newFakeGenerator(configuration.GenerationParams{})
+	model, config := greedy.model, greedy.config
+	beams := newGenerator(model, config, defaultGenerationParams(config).Override(configuration.GenerationParams{NumBeams: 2}), rand.New(rand.NewSource(42)))
+	short := newGenerator(model, config, defaultGenerationParams(config).Override(configuration.GenerationParams{MaxLength: 3}), rand.New(rand.NewSource(42)))
+
+	results := generateBatch([]generationRequest{
+		{generator: greedy, inputIDs: []int{1}, n: 1},
+		{generator: beams, inputIDs: []int{1, 2}, n: 2},
+		{generator: short, inputIDs: []int{1}, n: 1},
+	})
+	assert.Len(t, results, 3)
+	assert.Equal(t, []int{fakeStart, fakeA, fakeA, fakeA}, results[0][0].tokenIDs)
+	assert.Len(t, results[1], 2)
+	assert.Equal(t, []int{fakeStart, fakeB}, results[1][0].tokenIDs)
+	assert.Equal(t, []int{fakeStart, fakeA}, results[2][0].tokenIDs)
+}
+
+func TestBannedNGramTokens(t *testing.T) {
+	t.Parallel()
+	assert.Empty(t, bannedNGramTokens([]int{1}, 3))
+	assert.Equal(t, []int{2}, bannedNGramTokens([]int{1, 2, 1}, 2))
+	assert.Equal(t, []int{3, 4}, bannedNGramTokens([]int{1, 2, 3, 1, 2, 4, 1, 2}, 3))
+}
+
+func TestTopKIndices(t *testing.T) {
+	t.Parallel()
+	xs := []float64{0.1, 0.5, -1, 0.7, 0.5}
+	assert.Equal(t, []int{3, 1}, topKIndices(xs, 2))
+	assert.Equal(t, []int{3, 1, 4, 0, 2}, topKIndices(xs, 10))
+}
+
+// randomEncoderDecoder is a generation.EncoderDecoder whose next token
+// logits are pseudo-random, but deterministic, for each decoding sequence.
+type randomEncoderDecoder struct {
+	g         *ag.Graph
+	seed      int64
+	vocabSize int
+}
+
+func (r *randomEncoderDecoder) Graph() *ag.Graph {
+	return r.g
+}
+
+func (r *randomEncoderDecoder) Encode([]int) []ag.Node {
+	return []ag.Node{r.g.NewScalar(0)}
+}
+
+func (r *randomEncoderDecoder) Decode(_ []ag.Node, inputIDs []int, _ generation.Cache) (ag.Node, generation.Cache) {
+	return r.g.NewVariable(mat.NewVecDense(r.logits(inputIDs)), false), nil
+}
+
+func (r *randomEncoderDecoder) logits(inputIDs []int) []mat.Float {
+	seed := r.seed
+	for _, id := range inputIDs {
+		seed = seed*31 + int64(id)
+	}
+	rnd := rand.New(rand.NewSource(seed))
+	logits := make([]mat.Float, r.vocabSize)
+	for i := range logits {
+		logits[i] = mat.Float(rnd.NormFloat64() * 3)
+	}
+	return logits
+}
+
+// sequenceScore returns the score of a sequence ending with EOS, as
+// computed by the generator.
+func sequenceScore(gen *generator, model *randomEncoderDecoder, tokenIDs []int) float64 {
+	sumLogProbs := 0.0
+	for i := 1; i <= len(tokenIDs); i++ {
+		next := gen.config.EosTokenID
+		if i < len(tokenIDs) {
+			next = tokenIDs[i]
+		}
+		logits := make([]float64, model.vocabSize)
+		for j, v := range model.logits(tokenIDs[:i]) {
+			logits[j] = float64(v)
+		}
+		sumLogProbs += logSoftmax(gen.maskLogits(logits, tokenIDs[:i]))[next]
+	}
+	return normalizeScore(sumLogProbs, len(tokenIDs), gen.params.LengthPenalty)
+}
+
+// TestGeneratorMatchesSpago checks that, with the default generation
+// parameters of a model, the generator returns the same sequences as spaGO
+// generation.Generator, which was used before.
+//
+// The only expected difference is with beam search, when spaGO v0.7.0
+// Hypotheses.Add evicts the wrong finished hypothesis (its findWorst is off
+// by one): then the generator must find a better sequence than spaGO.
+func TestGeneratorMatchesSpago(t *testing.T) {
+	t.Parallel()
+
+	const vocabSize = 20
+	for _, numBeams := range []int{1, 2, 4} {
+		better := 0
+		for seed := int64(0); seed < 200; seed++ {
+			config := bartconfig.Config{
+				PadTokenID:          fakePad,
+				EosTokenID:          fakeEOS,
+				DecoderStartTokenID: fakeStart,
+				BosTokenID:          fakeStart,
+				VocabSize:           vocabSize,
+				NumBeams:            numBeams,
+				MaxLength:           8,
+				BadWordsIDs:         [][]int{{fakePad}},
+				IsEncoderDecoder:    true,
+			}
+			inputIDs := []int{fakeA, fakeEOS}
+
+			spagoModel := &randomEncoderDecoder{g: ag.NewGraph(ag.IncrementalForward(false)), seed: seed, vocabSize: vocabSize}
+			expected := generation.NewGenerator(generation.GeneratorConfig{
+				NumBeams:                  config.NumBeams,
+				MaxLength:                 config.MaxLength,
+				IsEncoderDecoder:          config.IsEncoderDecoder,
+				BOSTokenID:                config.BosTokenID,
+				EOSTokenID:                config.EosTokenID,
+				PadTokenID:                config.PadTokenID,
+				VocabSize:                 config.VocabSize,
+				DecoderStartTokenID:       config.DecoderStartTokenID,
+				LengthPenalty:             1.0,
+				BadWordsIDs:               config.BadWordsIDs,
+				MaxConcurrentComputations: 1,
+			}, spagoModel).Generate(inputIDs)
+			require.Equal(t, fakeEOS, expected[len(expected)-1])
+			expected = expected[:len(expected)-1]
+
+			model := &randomEncoderDecoder{g: ag.NewGraph(ag.IncrementalForward(false)), seed: seed, vocabSize: vocabSize}
+			gen := newGenerator(model, config, defaultGenerationParams(config), rand.New(rand.NewSource(42)))
+			hyps := gen.generate(inputIDs, 1)
+			require.Len(t, hyps, 1)
+			actual := hyps[0].tokenIDs
+
+			if numBeams == 1 || intsEqual(expected, actual) {
+				assert.Equal(t, expected, actual, "num beams %d, seed %d", numBeams, seed)
+				continue
+			}
+			assert.Greater(t, hyps[0].score, sequenceScore(gen, model, expected), "num beams %d, seed %d", numBeams, seed)
+			better++
+		}
+		assert.Less(t, better, 10, "num beams %d", numBeams)
+	}
+}
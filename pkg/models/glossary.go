@@ -0,0 +1,195 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+)
+
+// Glossaries holds the glossaries of the language pairs. It is safe for
+// concurrent use.
+//
+// Before translation, the glossary terms found in the text are replaced
+// with placeholders, which the models are expected to copy verbatim; after
+// translation, the placeholders are replaced with the target terms (see
+// maskText). If the models drop or repeat a placeholder, the text is
+// translated without the glossary (see Manager.translateMasked).
+type Glossaries struct {
+	mu       sync.RWMutex
+	byPair   map[glossaryPair]*glossary
+	revision uint64
+}
+
+type glossaryPair struct {
+	source string
+	target string
+}
+
+// glossary is the immutable set of terms of a language pair, sorted by
+// descending length of the source terms, so that the longest ones are
+// matched first.
+type glossary struct {
+	terms []configuration.GlossaryTerm
+}
+
+// NewGlossaries creates a new empty Glossaries.
+func NewGlossaries() *Glossaries {
+	return &Glossaries{byPair: make(map[glossaryPair]*glossary)}
+}
+
+// Set replaces the glossary of a language pair with the given terms.
+// An empty list of terms removes the glossary.
+func (g *Glossaries) Set(source, target string, terms []configuration.GlossaryTerm) error {
+	gl, err := newGlossary(terms)
+	if err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	key := glossaryPair{source: source, target: target}
+	if gl == nil {
+		delete(g.byPair, key)
+	} else {
+		g.byPair[key] = gl
+	}
+	g.revision++
+	return nil
+}
+
+// replace replaces all the glossaries at once.
+func (g *Glossaries) replace(byPair map[glossaryPair]*glossary) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.byPair = byPair
+	g.revision++
+}
+
+// newGlossary returns the glossary with the given terms, or nil if there
+// are no terms. It returns an error if any term is empty.
+func newGlossary(terms []configuration.GlossaryTerm) (*glossary, error) {
+	for i, term := range terms {
+		if strings.TrimSpace(term.Source) == "" || strings.TrimSpace(term.Target) == "" {
+			return nil, fmt.Errorf("invalid glossary term #%d: source and target must not be empty", i+1)
+		}
+	}
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	sorted := make([]configuration.GlossaryTerm, len(terms))
+	copy(sorted, terms)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i].Source) > len(sorted[j].Source)
+	})
+	return &glossary{terms: sorted}, nil
+}
+
+// configGlossaries builds the glossaries defined in a configuration,
+// attached to the normalized languages of the routes found among the given
+// models (see SetGlossary).
+func configGlossaries(config *configuration.Config, models modelsMap, aliases languageAliases) (map[glossaryPair]*glossary, error) {
+	byPair := make(map[glossaryPair]*glossary, len(config.Glossaries))
+	for _, g := range config.Glossaries {
+		terms, err := g.AllTerms()
+		if err != nil {
+			return nil, err
+		}
+		route, err := resolveRoute(config.PivotTranslation, models, aliases, g.Source, g.Target)
+		if err != nil {
+			return nil, fmt.Errorf("invalid glossary for translation from %#v to %#v: %w", g.Source, g.Target, err)
+		}
+		gl, err := newGlossary(terms)
+		if err != nil {
+			return nil, fmt.Errorf("invalid glossary for translation from %#v to %#v: %w", g.Source, g.Target, err)
+		}
+		if gl != nil {
+			byPair[glossaryPair{source: route[0].Source, target: route[len(route)-1].Target}] = gl
+		}
+	}
+	return byPair, nil
+}
+
+// Len returns the amount of terms in the glossary of a language pair.
+func (g *Glossaries) Len(source, target string) int {
+	gl := g.get(source, target)
+	if gl == nil {
+		return 0
+	}
+	return len(gl.terms)
+}
+
+// Revision returns a number which changes whenever any glossary is
+// changed. It allows to invalidate the translations computed with
+// previous glossaries.
+func (g *Glossaries) Revision() uint64 {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.revision
+}
+
+// get returns the glossary of a language pair, or nil if there is none.
+func (g *Glossaries) get(source, target string) *glossary {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.byPair[glossaryPair{source: source, target: target}]
+}
+
+// matches returns the non-overlapping glossary terms found in the text,
+// as spans to be masked, whose replacements are the target terms.
+//
+// Terms are only matched as whole words, and the longest ones take
+// precedence. A nil glossary has no matches.
+func (gl *glossary) matches(text string) []maskSpan {
+	if gl == nil {
+		return nil
+	}
+
+	var spans []maskSpan
+	for i := 0; i < len(text); {
+		if isWordBoundary(text, i) {
+			if term, ok := gl.match(text, i); ok {
+				end := i + len(term.Source)
+				spans = append(spans, maskSpan{start: i, end: end, replacement: term.Target})
+				i = end
+				continue
+			}
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+	}
+	return spans
+}
+
+// match returns the longest term matching the text at the given position.
+func (gl *glossary) match(text string, pos int) (configuration.GlossaryTerm, bool) {
+	for _, term := range gl.terms {
+		end := pos + len(term.Source)
+		if end > len(text) || !isWordBoundary(text, end) {
+			continue
+		}
+		candidate := text[pos:end]
+		if candidate == term.Source || (!term.CaseSensitive && strings.EqualFold(candidate, term.Source)) {
+			return term, true
+		}
+	}
+	return configuration.GlossaryTerm{}, false
+}
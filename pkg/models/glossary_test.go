@@ -0,0 +1,139 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlossaryMask(t *testing.T) {
+	t.Parallel()
+
+	g := NewGlossaries()
+	require.NoError(t, g.Set("en", "it", []configuration.GlossaryTerm{
+		{Source: "cloud", Target: "cloud"},
+		{Source: "cloud storage", Target: "archiviazione cloud"},
+		{Source: "Apple", Target: "Apple", CaseSensitive: true},
+	}))
+	gl := g.get("en", "it")
+	require.NotNil(t, gl)
+
+	text := "Apple sells Cloud Storage, not apples or an apple cloudy cloud."
+	masked, targets := maskText(text, gl.matches(text))
+	assert.Equal(t, "__0__ sells __1__, not apples or an apple cloudy __2__.", masked)
+	assert.Equal(t, []string{"Apple", "archiviazione cloud", "cloud"}, targets)
+
+	unmasked, ok := unmaskText("__0__ vende __ 1__, non mele o una mela nuvolosa _ _2_ _.", targets)
+	assert.True(t, ok)
+	assert.Equal(t, "Apple vende archiviazione cloud, non mele o una mela nuvolosa cloud.", unmasked)
+
+	masked, targets = maskText("Nothing to mask", g.get("it", "en").matches("Nothing to mask"))
+	assert.Equal(t, "Nothing to mask", masked)
+	assert.Nil(t, targets)
+}
+
+func TestGlossaryTranslateMasked(t *testing.T) {
+	t.Parallel()
+
+	g := NewGlossaries()
+	require.NoError(t, g.Set("en", "it", []configuration.GlossaryTerm{
+		{Source: "cloud storage", Target: "archiviazione cloud"},
+	}))
+	gl := g.get("en", "it")
+	mng := NewManager(&configuration.Config{}, zerolog.Nop())
+	text := "We sell cloud storage."
+
+	testCases := []struct {
+		name string
+		// translations are the outputs of the model for each input text.
+		translations map[string]string
+		want         string
+	}{
+		{
+			name:         "term restored",
+			translations: map[string]string{"We sell __0__.": "Vendiamo __0__."},
+			want:         "Vendiamo archiviazione cloud.",
+		},
+		{
+			name: "the model drops the term",
+			translations: map[string]string{
+				"We sell __0__.":         "Vendiamo.",
+				"We sell cloud storage.": "Vendiamo spazio di archiviazione.",
+			},
+			want: "Vendiamo spazio di archiviazione.",
+		},
+		{
+			name: "the model repeats the term",
+			translations: map[string]string{
+				"We sell __0__.":         "Vendiamo __0__ e __0__.",
+				"We sell cloud storage.": "Vendiamo spazio di archiviazione.",
+			},
+			want: "Vendiamo spazio di archiviazione.",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			translations, err := mng.translateMasked(text, gl.matches(text), func(s string) ([]Translation, error) {
+				translation, ok := tc.translations[s]
+				require.True(t, ok, s)
+				return []Translation{{Text: translation}}, nil
+			})
+			require.NoError(t, err)
+			require.Len(t, translations, 1)
+			assert.Equal(t, tc.want, translations[0].Text)
+		})
+	}
+}
+
+func TestGlossaries(t *testing.T) {
+	t.Parallel()
+
+	g := NewGlossaries()
+	rev := g.Revision()
+
+	assert.Error(t, g.Set("en", "it", []configuration.GlossaryTerm{{Source: "foo"}}))
+	assert.Equal(t, rev, g.Revision())
+
+	require.NoError(t, g.Set("en", "it", []configuration.GlossaryTerm{{Source: "foo", Target: "bar"}}))
+	assert.Equal(t, 1, g.Len("en", "it"))
+	assert.NotEqual(t, rev, g.Revision())
+
+	require.NoError(t, g.Set("en", "it", nil))
+	assert.Equal(t, 0, g.Len("en", "it"))
+	assert.Nil(t, g.get("en", "it"))
+}
+
+func TestManagerSetGlossary(t *testing.T) {
+	t.Parallel()
+
+	mng := newRouteTestManager(configuration.PivotTranslation{}, [2]string{"en", "it"})
+	terms := []configuration.GlossaryTerm{{Source: "foo", Target: "bar"}}
+
+	source, target, err := mng.SetGlossary("EN-us", "ita", terms)
+	require.NoError(t, err)
+	assert.Equal(t, "en", source)
+	assert.Equal(t, "it", target)
+	assert.Equal(t, 1, mng.Glossaries().Len("en", "it"))
+
+	_, _, err = mng.SetGlossary("it", "en", terms)
+	assert.Error(t, err)
+}
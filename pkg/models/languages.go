@@ -0,0 +1,113 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"golang.org/x/text/language"
+	"strings"
+)
+
+// NormalizeLanguage returns the canonical form of a language code.
+//
+// Valid BCP-47 tags are canonicalized: case is normalized (e.g. "EN-us"
+// becomes "en-US"), underscores are accepted as separators, and ISO 639-2
+// and 639-3 codes, as well as deprecated codes, are replaced with their
+// ISO 639-1 equivalents, where available (e.g. "eng" becomes "en").
+// Any other code is only trimmed and lowercased.
+func NormalizeLanguage(code string) string {
+	code = strings.TrimSpace(code)
+	tag, err := language.Parse(code)
+	if err != nil {
+		return strings.ToLower(code)
+	}
+	return tag.String()
+}
+
+// parentLanguages returns the given normalized language code followed by
+// its less specific forms, obtained removing the subtags one by one
+// (e.g. "zh-Hant-TW", "zh-Hant", "zh").
+func parentLanguages(code string) []string {
+	codes := []string{code}
+	for i := strings.LastIndexByte(code, '-'); i > 0; i = strings.LastIndexByte(code, '-') {
+		code = code[:i]
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+// languageAliases maps normalized aliases to normalized language codes.
+type languageAliases map[string]string
+
+// newLanguageAliases collects the source and target aliases from the
+// configured language models. It returns an error if the same alias refers
+// to different languages.
+func newLanguageAliases(lms []configuration.LanguageModel) (languageAliases, error) {
+	aliases := make(languageAliases)
+	add := func(alias, lang string) error {
+		alias = NormalizeLanguage(alias)
+		lang = NormalizeLanguage(lang)
+		if alias == lang {
+			return nil
+		}
+		if other, ok := aliases[alias]; ok && other != lang {
+			return fmt.Errorf("language alias %#v refers to both %#v and %#v", alias, other, lang)
+		}
+		aliases[alias] = lang
+		return nil
+	}
+
+	for _, lm := range lms {
+		for _, alias := range lm.SourceAliases {
+			if err := add(alias, lm.Source); err != nil {
+				return nil, err
+			}
+		}
+		for _, alias := range lm.TargetAliases {
+			if err := add(alias, lm.Target); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return aliases, nil
+}
+
+// candidates returns the normalized language codes which can match the
+// given code, from the most to the least specific one. Aliases are
+// resolved at each level.
+func (la languageAliases) candidates(code string) []string {
+	parents := parentLanguages(NormalizeLanguage(code))
+	codes := make([]string, 0, len(parents))
+	for _, c := range parents {
+		if lang, ok := la[c]; ok {
+			c = lang
+		}
+		codes = append(codes, c)
+	}
+	return codes
+}
+
+// of returns the aliases of the given normalized language code, in no
+// particular order.
+func (la languageAliases) of(lang string) []string {
+	var aliases []string
+	for alias, l := range la {
+		if l == lang {
+			aliases = append(aliases, alias)
+		}
+	}
+	return aliases
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLanguage(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"en":         "en",
+		"EN":         "en",
+		" en-us ":    "en-US",
+		"en_GB":      "en-GB",
+		"eng":        "en",
+		"deu":        "de",
+		"ger":        "de",
+		"iw":         "he",
+		"zh-hant-tw": "zh-Hant-TW",
+		"Custom":     "custom",
+	}
+	for code, expected := range testCases {
+		assert.Equal(t, expected, NormalizeLanguage(code), code)
+	}
+}
+
+func TestManagerLookupPair(t *testing.T) {
+	t.Parallel()
+
+	mng := newRouteTestManager(configuration.PivotTranslation{},
+		[2]string{"en", "it"}, [2]string{"pt-BR", "en"}, [2]string{"nb", "en"})
+	aliases, err := newLanguageAliases([]configuration.LanguageModel{
+		{Source: "nb", Target: "en", SourceAliases: []string{"no", "nob"}},
+		{Source: "en", Target: "it", TargetAliases: []string{"italian"}},
+	})
+	require.NoError(t, err)
+	mng.aliases = aliases
+
+	testCases := []struct {
+		source, target string
+		expected       string
+	}{
+		{"en", "it", "en-it"},
+		{"EN", "IT", "en-it"},
+		{"en-US", "it", "en-it"},
+		{"eng", "ita", "en-it"},
+		{"en", "italian", "en-it"},
+		{"pt-br", "en-GB", "pt-BR-en"},
+		{"no", "en", "nb-en"},
+		{"nob", "en", "nb-en"},
+	}
+	for _, tc := range testCases {
+		pair, ok := mng.LookupPair(tc.source, tc.target)
+		if assert.True(t, ok, "%s-%s", tc.source, tc.target) {
+			assert.Equal(t, tc.expected, pair.Model.Name())
+		}
+	}
+
+	_, ok := mng.LookupPair("pt", "en")
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{"italian"}, mng.aliases.of("it"))
+
+	_, err = newLanguageAliases([]configuration.LanguageModel{
+		{Source: "nb", Target: "en", SourceAliases: []string{"no"}},
+		{Source: "nn", Target: "en", SourceAliases: []string{"no"}},
+	})
+	assert.Error(t, err)
+}
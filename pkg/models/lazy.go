@@ -0,0 +1,250 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/SpecializedGeneralist/translator/pkg/metrics"
+	"github.com/rs/zerolog"
+)
+
+// residentModels keeps track of the models loaded on first use, when lazy
+// loading is enabled, evicting the least recently used idle models to
+// satisfy the configured limits.
+type residentModels struct {
+	// maxModels is the maximum amount of resident models (0 means no limit).
+	maxModels int
+	// memoryBudget is the maximum estimated memory of the resident models,
+	// in bytes (0 means no limit).
+	memoryBudget int64
+	// loader loads a model. It is Model.Load, replaced in tests.
+	loader func(*Model) error
+	// unloader unloads a model. It is Model.Unload, replaced in tests.
+	unloader func(*Model) error
+	logger   zerolog.Logger
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// lru holds the *residentModel items, from the most recently used.
+	lru   *list.List
+	items map[*Model]*list.Element
+	// loads are the loads and evictions in progress: concurrent
+	// acquisitions of the same model wait for them.
+	loads map[*Model]*modelLoad
+}
+
+// residentModel is a loaded model tracked by residentModels.
+type residentModel struct {
+	model *Model
+	// size is the estimated memory used by the model, in bytes.
+	size int64
+	// inUse is the amount of translations currently using the model.
+	inUse int
+	// lastUsed is when the model was last acquired.
+	lastUsed time.Time
+}
+
+// modelLoad is a model load (or eviction) in progress.
+type modelLoad struct {
+	done chan struct{}
+	err  error
+}
+
+// newResidentModels returns a new residentModels for the given
+// configuration, or nil if lazy loading is disabled.
+func newResidentModels(config configuration.LazyLoading, logger zerolog.Logger) *residentModels {
+	if !config.Enabled {
+		return nil
+	}
+	return &residentModels{
+		maxModels:    config.MaxResidentModels,
+		memoryBudget: int64(config.MemoryBudgetMB) << 20,
+		loader:       (*Model).Load,
+		unloader:     (*Model).Unload,
+		logger:       logger,
+		lru:          list.New(),
+		items:        make(map[*Model]*list.Element),
+		loads:        make(map[*Model]*modelLoad),
+	}
+}
+
+// acquire marks the model as in use, loading it first, if necessary.
+// Concurrent acquisitions of a model which is not loaded share a single
+// load. Every successful acquisition must be followed by a call to release.
+//
+// A nil residentModels acquires nothing.
+func (r *residentModels) acquire(model *Model) error {
+	if r == nil {
+		return nil
+	}
+	for {
+		r.mu.Lock()
+		if el, ok := r.items[model]; ok {
+			if model.Status() == StatusLoaded {
+				rm := el.Value.(*residentModel)
+				rm.inUse++
+				rm.lastUsed = time.Now()
+				r.lru.MoveToFront(el)
+				r.mu.Unlock()
+				return nil
+			}
+			// The model was unloaded elsewhere while being loaded here.
+			r.lru.Remove(el)
+			delete(r.items, model)
+		}
+		if load, ok := r.loads[model]; ok {
+			r.mu.Unlock()
+			<-load.done
+			if load.err != nil {
+				return load.err
+			}
+			continue // loaded (or evicted) in the meantime
+		}
+		load := &modelLoad{done: make(chan struct{})}
+		r.loads[model] = load
+		r.mu.Unlock()
+
+		return r.load(model, load)
+	}
+}
+
+// load loads a model on behalf of acquire, making room for it first, and
+// marks it as in use.
+func (r *residentModels) load(model *Model, load *modelLoad) error {
+	r.evict(1, model.estimatedSize())
+
+	if model.Status() != StatusLoaded {
+		load.err = r.loader(model)
+	}
+
+	r.mu.Lock()
+	delete(r.loads, model)
+	if load.err == nil {
+		r.items[model] = r.lru.PushFront(&residentModel{
+			model:    model,
+			size:     model.estimatedSize(),
+			inUse:    1,
+			lastUsed: time.Now(),
+		})
+		metrics.ResidentModels.Set(float64(len(r.items)))
+	}
+	close(load.done)
+	r.mu.Unlock()
+
+	if load.err != nil {
+		return load.err
+	}
+	// The estimated size might have been unknown before loading (e.g. if
+	// the model had to be downloaded), so the limits are enforced again.
+	r.evict(0, 0)
+	return nil
+}
+
+// release marks the model as no longer in use by a translation. Then, if
+// the limits are exceeded (because all the models were in use), idle
+// models are evicted.
+func (r *residentModels) release(model *Model) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	if el, ok := r.items[model]; ok {
+		el.Value.(*residentModel).inUse--
+	}
+	r.mu.Unlock()
+	r.evict(0, 0)
+}
+
+// forget stops tracking a model, which is going to be unloaded elsewhere.
+func (r *residentModels) forget(model *Model) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if el, ok := r.items[model]; ok {
+		r.lru.Remove(el)
+		delete(r.items, model)
+		metrics.ResidentModels.Set(float64(len(r.items)))
+	}
+}
+
+// usage returns the amount of translations using a model and when it was
+// last acquired. It reports false if the model is not tracked.
+func (r *residentModels) usage(model *Model) (inUse int, lastUsed time.Time, ok bool) {
+	if r == nil {
+		return 0, time.Time{}, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	el, ok := r.items[model]
+	if !ok {
+		return 0, time.Time{}, false
+	}
+	rm := el.Value.(*residentModel)
+	return rm.inUse, rm.lastUsed, true
+}
+
+// evict unloads the least recently used idle models, until the resident
+// models, plus the given amount of new models with the given estimated
+// size, are within the limits, or no more idle models are left.
+func (r *residentModels) evict(newModels int, newSize int64) {
+	var victims []*Model
+
+	r.mu.Lock()
+	count := len(r.items) + newModels
+	size := newSize
+	for _, el := range r.items {
+		size += el.Value.(*residentModel).size
+	}
+	for el := r.lru.Back(); el != nil && r.exceeds(count, size); {
+		rm := el.Value.(*residentModel)
+		prev := el.Prev()
+		if rm.inUse == 0 {
+			r.lru.Remove(el)
+			delete(r.items, rm.model)
+			r.loads[rm.model] = &modelLoad{done: make(chan struct{})}
+			victims = append(victims, rm.model)
+			count--
+			size -= rm.size
+		}
+		el = prev
+	}
+	metrics.ResidentModels.Set(float64(len(r.items)))
+	r.mu.Unlock()
+
+	for _, model := range victims {
+		r.logger.Info().Str("model", model.Name()).Msg("evicting least recently used model")
+		metrics.ModelEvictions.Inc()
+		if err := r.unloader(model); err != nil {
+			r.logger.Err(err).Str("model", model.Name()).Msg("error unloading model")
+		}
+		r.mu.Lock()
+		close(r.loads[model].done)
+		delete(r.loads, model)
+		r.mu.Unlock()
+	}
+}
+
+// exceeds reports whether the given amount of models, with the given
+// estimated size, exceeds the limits.
+func (r *residentModels) exceeds(count int, size int64) bool {
+	return (r.maxModels > 0 && count > r.maxModels) ||
+		(r.memoryBudget > 0 && size > r.memoryBudget)
+}
@@ -0,0 +1,158 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestResidentModels returns a residentModels with fake loader and
+// unloader, which only update the status of the models, counting the
+// loads.
+func newTestResidentModels(maxModels int, loads *int32) *residentModels {
+	r := newResidentModels(configuration.LazyLoading{
+		Enabled:           true,
+		MaxResidentModels: maxModels,
+	}, zerolog.Nop())
+	r.loader = func(m *Model) error {
+		atomic.AddInt32(loads, 1)
+		time.Sleep(10 * time.Millisecond)
+		m.setStatus(StatusLoaded)
+		return nil
+	}
+	r.unloader = func(m *Model) error {
+		m.setStatus(StatusNotLoaded)
+		return nil
+	}
+	return r
+}
+
+func newTestModel(name string) *Model {
+	config := &configuration.Config{ModelsPath: "/nonexistent"}
+	return NewModel(config, configuration.LanguageModel{Model: name}, zerolog.Nop())
+}
+
+func TestResidentModels(t *testing.T) {
+	t.Parallel()
+
+	t.Run("concurrent acquisitions share a single load", func(t *testing.T) {
+		t.Parallel()
+		var loads int32
+		r := newTestResidentModels(0, &loads)
+		model := newTestModel("a")
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, r.acquire(model))
+				r.release(model)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), loads)
+		assert.Equal(t, StatusLoaded, model.Status())
+	})
+
+	t.Run("least recently used idle models are evicted", func(t *testing.T) {
+		t.Parallel()
+		var loads int32
+		r := newTestResidentModels(2, &loads)
+		a, b, c := newTestModel("a"), newTestModel("b"), newTestModel("c")
+
+		for _, m := range []*Model{a, b, a} {
+			require.NoError(t, r.acquire(m))
+			r.release(m)
+		}
+		require.NoError(t, r.acquire(c))
+		r.release(c)
+
+		assert.Equal(t, int32(3), loads)
+		assert.Equal(t, StatusLoaded, a.Status())
+		assert.Equal(t, StatusNotLoaded, b.Status())
+		assert.Equal(t, StatusLoaded, c.Status())
+
+		require.NoError(t, r.acquire(b))
+		r.release(b)
+		assert.Equal(t, int32(4), loads)
+		assert.Equal(t, StatusNotLoaded, a.Status())
+	})
+
+	t.Run("models in use are not evicted", func(t *testing.T) {
+		t.Parallel()
+		var loads int32
+		r := newTestResidentModels(1, &loads)
+		a, b := newTestModel("a"), newTestModel("b")
+
+		require.NoError(t, r.acquire(a))
+		require.NoError(t, r.acquire(b))
+		assert.Equal(t, StatusLoaded, a.Status())
+		assert.Equal(t, StatusLoaded, b.Status())
+
+		r.release(a)
+		assert.Equal(t, StatusNotLoaded, a.Status())
+		r.release(b)
+		assert.Equal(t, StatusLoaded, b.Status())
+	})
+
+	t.Run("failed loads are retried", func(t *testing.T) {
+		t.Parallel()
+		var loads int32
+		r := newTestResidentModels(0, &loads)
+		load := r.loader
+		r.loader = func(m *Model) error {
+			if atomic.LoadInt32(&loads) == 0 {
+				atomic.AddInt32(&loads, 1)
+				return fmt.Errorf("boom")
+			}
+			return load(m)
+		}
+		model := newTestModel("a")
+
+		assert.EqualError(t, r.acquire(model), "boom")
+		require.NoError(t, r.acquire(model))
+		r.release(model)
+		assert.Equal(t, int32(2), loads)
+	})
+}
+
+func TestManagerLazyLoading(t *testing.T) {
+	t.Parallel()
+
+	config := &configuration.Config{
+		LazyLoading: configuration.LazyLoading{Enabled: true},
+		LanguageModels: []configuration.LanguageModel{
+			{Source: "en", Target: "it", Model: "en-it"},
+		},
+	}
+	mng := NewManager(config, zerolog.Nop())
+	require.NoError(t, mng.LoadModels())
+
+	model, ok := mng.GetModel("en", "it")
+	require.True(t, ok)
+	assert.Equal(t, StatusNotLoaded, model.Status())
+	assert.True(t, mng.ModelsLoaded())
+}
@@ -18,41 +18,132 @@ import (
 	"fmt"
 	"github.com/SpecializedGeneralist/translator/pkg/configuration"
 	"github.com/rs/zerolog"
+	"sort"
+	"strings"
+	"sync"
 )
 
 // Manager allows easy handling of multiple translation models.
 type Manager struct {
-	config *configuration.Config
-	models modelsMap
-	logger zerolog.Logger
+	// mu protects config, models, aliases and entities, which are replaced
+	// altogether when the configuration is reloaded.
+	mu      sync.RWMutex
+	config  *configuration.Config
+	models  modelsMap
+	aliases languageAliases
+	// entities is nil if entity protection is disabled.
+	entities *entityDetector
+	// loadMu serializes the loading and reloading of the models.
+	loadMu sync.Mutex
+	// resident is nil if lazy loading is disabled.
+	resident *residentModels
+	// users keeps the models removed by a reload loaded, until the
+	// requests holding them are completed.
+	users      *modelUsers
+	memory     TranslationMemory
+	glossaries *Glossaries
+	logger     zerolog.Logger
 }
 
 // NewManager creates a new Manager.
+//
+// If lazy loading is enabled in the configuration, the models are loaded
+// on first use, rather than by LoadModels, and the least recently used ones
+// are unloaded when the configured limits are exceeded. The lazy loading
+// settings are not affected by Reload.
 func NewManager(config *configuration.Config, logger zerolog.Logger) *Manager {
 	return &Manager{
-		config: config,
-		models: make(modelsMap, 1),
-		logger: logger,
+		config:     config,
+		models:     make(modelsMap, 1),
+		aliases:    make(languageAliases),
+		resident:   newResidentModels(config.LazyLoading, logger),
+		users:      newModelUsers(logger),
+		glossaries: NewGlossaries(),
+		logger:     logger,
 	}
 }
 
-// modelsMap maps [source][target] => *Model
-type modelsMap map[string]map[string]*Model
+// SetTranslationMemory sets the TranslationMemory to be used for
+// translations. It must be called before serving any translation.
+func (mng *Manager) SetTranslationMemory(memory TranslationMemory) {
+	mng.memory = memory
+}
+
+// Glossaries returns the glossaries used for translations.
+func (mng *Manager) Glossaries() *Glossaries {
+	return mng.glossaries
+}
+
+// SetGlossary replaces the glossary of a language pair with the given
+// terms. An empty list of terms removes the glossary.
+//
+// The language pair must be supported, either directly or through
+// intermediate languages (see Route). The glossary is attached to the
+// matching normalized languages, which are returned.
+func (mng *Manager) SetGlossary(source, target string, terms []configuration.GlossaryTerm) (string, string, error) {
+	route, err := mng.Route(source, target)
+	if err != nil {
+		return "", "", err
+	}
+	source = route[0].Source
+	target = route[len(route)-1].Target
+	if err = mng.glossaries.Set(source, target, terms); err != nil {
+		return "", "", err
+	}
+	return source, target, nil
+}
+
+// logGlossaries logs the glossaries loaded from the configuration.
+func (mng *Manager) logGlossaries(glossaries map[glossaryPair]*glossary) {
+	for pair, gl := range glossaries {
+		mng.logger.Info().Str("source", pair.source).Str("target", pair.target).
+			Int("terms", len(gl.terms)).Msg("glossary loaded")
+	}
+}
+
+// Config returns the current configuration of the Manager, which is
+// replaced on Reload.
+func (mng *Manager) Config() *configuration.Config {
+	mng.mu.RLock()
+	defer mng.mu.RUnlock()
+	return mng.config
+}
+
+// modelsMap maps [source][target] => models of the pair, in configuration
+// order, where source and target are normalized language codes (see
+// NormalizeLanguage).
+type modelsMap map[string]map[string][]WeightedModel
 
-// LoadModels loads all models according to the configuration.
+// WeightedModel is a Model serving a share of the translations of a
+// language pair.
+type WeightedModel struct {
+	Model *Model
+	// Weight is the share of translations served by the model, relative to
+	// the other models of the same pair (see SelectModels).
+	Weight int
+}
+
+// LoadModels loads all models according to the configuration, and sets the
+// glossaries it defines.
 // If a model path is not found, automatic download and conversion
 // are performed using spaGO huggingface Downloader and Converter.
+//
+// With lazy loading, the models are only prepared, and they are loaded
+// on first use instead.
 func (mng *Manager) LoadModels() error {
+	mng.loadMu.Lock()
+	defer mng.loadMu.Unlock()
+
 	mng.logger.Info().Msg("loading all models...")
-	if len(mng.models) > 0 {
+	mng.mu.RLock()
+	config, loaded := mng.config, len(mng.models) > 0
+	mng.mu.RUnlock()
+	if loaded {
 		return fmt.Errorf("models already loaded")
 	}
 
-	for _, lm := range mng.config.LanguageModels {
-		err := mng.loadModel(lm)
-		if err != nil {
-			return err
-		}
+	if err := mng.apply(config); err != nil {
+		return err
 	}
 
 	mng.logger.Info().Msg("all models loaded successfully")
@@ -62,40 +153,392 @@ func (mng *Manager) LoadModels() error {
 // GetModel returns a Model for translating texts from the given source
 // language to the given target language. It also reports whether a model for
 // that pair or languages is present (previously loaded).
+//
+// If several models are configured for the pair, the first one is
+// returned.
+//
+// With lazy loading, the returned model might not be loaded yet: it is
+// loaded when translating texts through the Manager.
+//
+// Language codes are matched as described for LookupPair.
 func (mng *Manager) GetModel(source, target string) (*Model, bool) {
-	sourceMap, sourceOk := mng.models[source]
-	if !sourceOk {
-		return nil, false
+	pair, ok := mng.LookupPair(source, target)
+	return pair.Model, ok
+}
+
+// LookupPair looks for a model translating texts from the given source
+// language to the given target language, returning the matching language
+// pair. It also reports whether such a pair is present.
+//
+// Language codes are normalized (see NormalizeLanguage) and resolved
+// through the configured aliases. If no exact match is found, less
+// specific codes are tried (e.g. "en" for "en-US").
+func (mng *Manager) LookupPair(source, target string) (LanguagePair, bool) {
+	mng.mu.RLock()
+	defer mng.mu.RUnlock()
+	return mng.lookupPair(source, target)
+}
+
+// lookupPair implements LookupPair. It must be called with mu locked.
+func (mng *Manager) lookupPair(source, target string) (LanguagePair, bool) {
+	return findPair(mng.models, mng.aliases, source, target)
+}
+
+// findPair looks for a language pair among the given models, as described
+// for LookupPair.
+func findPair(models modelsMap, aliases languageAliases, source, target string) (LanguagePair, bool) {
+	for _, s := range aliases.candidates(source) {
+		sourceMap, ok := models[s]
+		if !ok {
+			continue
+		}
+		for _, t := range aliases.candidates(target) {
+			if pairModels, ok := sourceMap[t]; ok {
+				return newLanguagePair(s, t, pairModels), true
+			}
+		}
+	}
+	return LanguagePair{}, false
+}
+
+// ModelsLoaded reports whether the models for all the configured language
+// pairs are loaded. With lazy loading, it always reports true, since models
+// are loaded on demand.
+func (mng *Manager) ModelsLoaded() bool {
+	if mng.resident != nil {
+		return true
+	}
+	mng.mu.RLock()
+	defer mng.mu.RUnlock()
+	for _, lm := range mng.config.LanguageModels {
+		pair, ok := mng.lookupPair(lm.Source, lm.Target)
+		if !ok {
+			return false
+		}
+		model, ok := pair.modelNamed(lm.Model)
+		if !ok || model.Status() != StatusLoaded {
+			return false
+		}
+	}
+	return true
+}
+
+// LanguagePair associates a source/target languages pair with the Model
+// handling translations between them.
+type LanguagePair struct {
+	Source string
+	Target string
+	// Model is the model serving the translations: the first of Models,
+	// unless another one is chosen with SelectModels.
+	Model *Model
+	// Models are all the models configured for the pair, in configuration
+	// order.
+	Models []WeightedModel
+	// SourceAliases and TargetAliases are the configured aliases of the
+	// source and target languages. They are only set by LanguagePairs.
+	SourceAliases []string
+	TargetAliases []string
+}
+
+// LanguagePairs returns all the language pairs known to the Manager,
+// sorted by source and target language.
+func (mng *Manager) LanguagePairs() []LanguagePair {
+	mng.mu.RLock()
+	defer mng.mu.RUnlock()
+
+	pairs := make([]LanguagePair, 0, len(mng.models))
+	for source, sourceMap := range mng.models {
+		for target, models := range sourceMap {
+			pair := newLanguagePair(source, target, models)
+			pair.SourceAliases = sortedStrings(mng.aliases.of(source))
+			pair.TargetAliases = sortedStrings(mng.aliases.of(target))
+			pairs = append(pairs, pair)
+		}
 	}
-	model, modelOk := sourceMap[target]
-	if !modelOk {
-		return nil, false
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Source != pairs[j].Source {
+			return pairs[i].Source < pairs[j].Source
+		}
+		return pairs[i].Target < pairs[j].Target
+	})
+	return pairs
+}
+
+// newLanguagePair returns a LanguagePair with the given models, the first
+// of which serves the translations.
+func newLanguagePair(source, target string, models []WeightedModel) LanguagePair {
+	return LanguagePair{
+		Source: source,
+		Target: target,
+		Model:  models[0].Model,
+		Models: models,
 	}
-	return model, true
+}
+
+// modelNamed returns the model of the pair with the given name. It also
+// reports whether such a model is present.
+func (p LanguagePair) modelNamed(name string) (*Model, bool) {
+	model := findModel(p.Models, name)
+	return model, model != nil
 }
 
 // Translate is a convenience method to get a model and perform translation
 // in a single step.
-func (mng *Manager) Translate(source, target, text string) (string, error) {
-	model, modelFound := mng.GetModel(source, target)
-	if !modelFound {
-		return "", fmt.Errorf("no model available for translation from %#v to %#v", source, target)
+//
+// If no model is available for the language pair, the text may be
+// translated through intermediate languages (see Route). If several models
+// are available for a language pair, one of them is chosen according to
+// their weights (see SelectModels).
+//
+// The text is split into sentences (see SplitSegments), which are
+// translated one by one. The original white space between sentences,
+// including line and paragraph breaks, is preserved.
+//
+// The given generation parameters are validated against the configured
+// GenerationLimits, then passed to Model.Translate.
+func (mng *Manager) Translate(source, target, text string, params configuration.GenerationParams) (string, error) {
+	translations, err := mng.TranslateAlternatives(source, target, text, params, 1, TranslateOptions{})
+	if err != nil {
+		return "", err
 	}
+	return translations[0].Text, nil
+}
 
-	translatedText := model.Translate(text)
-	return translatedText, nil
+// TranslateAlternatives is like Translate, but it returns up to n
+// alternative translations, sorted by descending score.
+//
+// Each sentence is translated with Model.TranslateAlternatives. Then, the
+// i-th alternative for the whole text is composed of the i-th alternative
+// of each sentence (or its last one, if there are not enough), and its
+// score is the average of the sentences scores.
+//
+// When translating through intermediate languages, only the best
+// translation is used for each intermediate step, and the alternatives come
+// from the last step only.
+//
+// Before translation, the terms to keep verbatim from the options, the
+// terms of the glossary of the language pair (see Glossaries) and, if
+// enabled, the protected entities (such as URLs) found in each sentence are
+// replaced with placeholders, which are restored afterwards (see
+// translateMasked).
+//
+// With lazy loading, the models along the route are loaded first, if
+// necessary.
+func (mng *Manager) TranslateAlternatives(
+	source, target, text string,
+	params configuration.GenerationParams,
+	n int,
+	opts TranslateOptions,
+) ([]Translation, error) {
+	route, release, err := mng.SelectRoute(source, target, opts.Model)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return mng.TranslateRoute(route, text, params, n, opts)
+}
+
+// TranslateRoute is like TranslateAlternatives, but it translates the
+// text along the given route (see Route), with the Model of each language
+// pair (see SelectModels). The Model option is ignored.
+func (mng *Manager) TranslateRoute(
+	route []LanguagePair,
+	text string,
+	params configuration.GenerationParams,
+	n int,
+	opts TranslateOptions,
+) ([]Translation, error) {
+	if err := mng.CheckParams(params, n); err != nil {
+		return nil, err
+	}
+	mng.mu.RLock()
+	entities := mng.entities
+	mng.mu.RUnlock()
+
+	release, err := mng.acquireRoute(route)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	glossary := mng.glossaries.get(route[0].Source, route[len(route)-1].Target)
+
+	segments := SplitSegments(text, route[0].Source)
+	segmentsTranslations := make([][]Translation, len(segments))
+	numAlternatives := 1
+	for i, segment := range segments {
+		var spans []maskSpan
+		spans = append(spans, termsSpans(segment.Text, opts.DoNotTranslate)...)
+		spans = append(spans, glossary.matches(segment.Text)...)
+		spans = append(spans, entities.spans(segment.Text)...)
+		translations, err := mng.translateMasked(segment.Text, spans, func(text string) ([]Translation, error) {
+			return mng.translateSegmentRoute(route, text, params, n)
+		})
+		if err != nil {
+			return nil, err
+		}
+		segmentsTranslations[i] = translations
+		if len(translations) > numAlternatives {
+			numAlternatives = len(translations)
+		}
+	}
+
+	alternatives := make([]Translation, numAlternatives)
+	for i := range alternatives {
+		alternatives[i] = joinSegmentsTranslations(text, segments, segmentsTranslations, i)
+	}
+	sort.SliceStable(alternatives, func(i, j int) bool {
+		return alternatives[i].Score > alternatives[j].Score
+	})
+	return alternatives, nil
+}
+
+// CheckParams returns an error if the given generation parameters, or the
+// number of alternatives, are invalid or exceed the configured
+// GenerationLimits.
+func (mng *Manager) CheckParams(params configuration.GenerationParams, n int) error {
+	limits := mng.Config().GenerationLimits
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := limits.Check(params); err != nil {
+		return err
+	}
+	if n < 1 {
+		return fmt.Errorf("invalid number of alternatives %d: it must be positive", n)
+	}
+	if max := limits.MaxNumBeams; max > 0 && n > max {
+		return fmt.Errorf("number of alternatives %d exceeds the limit of %d", n, max)
+	}
+	return nil
+}
+
+// acquireRoute holds the models along the route (see holdRoute), and
+// acquires them (see residentModels.acquire), returning a function which
+// releases them.
+func (mng *Manager) acquireRoute(route []LanguagePair) (func(), error) {
+	unhold, err := mng.holdRoute(route)
+	if err != nil {
+		return nil, err
+	}
+	acquired := make([]*Model, 0, len(route))
+	release := func() {
+		for _, model := range acquired {
+			mng.resident.release(model)
+		}
+		unhold()
+	}
+	for _, pair := range route {
+		if err := mng.resident.acquire(pair.Model); err != nil {
+			release()
+			return nil, err
+		}
+		if mng.users.isRetired(pair.Model) {
+			// The model was removed by a reload: it is kept loaded by
+			// the hold, rather than by the resident models.
+			mng.resident.forget(pair.Model)
+			continue
+		}
+		acquired = append(acquired, pair.Model)
+	}
+	return release, nil
+}
+
+// translateSegmentRoute translates a single segment along the given route.
+func (mng *Manager) translateSegmentRoute(route []LanguagePair, segment string, params configuration.GenerationParams, n int) ([]Translation, error) {
+	last := len(route) - 1
+	for _, pair := range route[:last] {
+		translations, err := mng.translateSegment(pair.Source, pair.Target, pair.Model, segment, params, 1)
+		if err != nil {
+			return nil, err
+		}
+		segment = translations[0].Text
+	}
+	pair := route[last]
+	return mng.translateSegment(pair.Source, pair.Target, pair.Model, segment, params, n)
+}
+
+// translateSegment translates a single segment with the given model,
+// consulting the translation memory first, if possible.
+func (mng *Manager) translateSegment(
+	source, target string,
+	model *Model,
+	segment string,
+	params configuration.GenerationParams,
+	n int,
+) ([]Translation, error) {
+	if mng.memory == nil || n != 1 || params != (configuration.GenerationParams{}) {
+		return model.TranslateAlternatives(segment, params, n)
+	}
+	key, ok := memoryKey(source, target, model, segment)
+	if !ok {
+		return model.TranslateAlternatives(segment, params, n)
+	}
+
+	translation, found, err := mng.memory.Get(key)
+	if err != nil {
+		mng.logger.Warn().Err(err).Msg("translation memory lookup failed")
+	}
+	if found {
+		return []Translation{translation}, nil
+	}
+
+	translations, err := model.TranslateAlternatives(segment, params, n)
+	if err != nil {
+		return nil, err
+	}
+	if err = mng.memory.Put(key, translations[0]); err != nil {
+		mng.logger.Warn().Err(err).Msg("translation memory update failed")
+	}
+	return translations, nil
 }
 
-func (mng *Manager) loadModel(ln configuration.LanguageModel) error {
-	if _, ok := mng.models[ln.Source]; !ok {
-		mng.models[ln.Source] = make(map[string]*Model, 1)
+// joinSegmentsTranslations composes the translation of a whole text from the
+// index-th translation of each segment, preserving the original white
+// space between segments.
+func joinSegmentsTranslations(text string, segments []Segment, translations [][]Translation, index int) Translation {
+	var sb strings.Builder
+	sb.Grow(len(text))
+
+	score := 0.0
+	lastEnd := 0
+	for i, segment := range segments {
+		sb.WriteString(text[lastEnd:segment.Start])
+		st := translations[i]
+		t := st[len(st)-1]
+		if index < len(st) {
+			t = st[index]
+		}
+		sb.WriteString(t.Text)
+		score += t.Score
+		lastEnd = segment.End
 	}
-	if _, ok := mng.models[ln.Source][ln.Target]; ok {
-		return fmt.Errorf("a model was already loaded for translation from %#v to %#v", ln.Source, ln.Target)
+	sb.WriteString(text[lastEnd:])
+
+	if len(segments) > 0 {
+		score /= float64(len(segments))
 	}
+	return Translation{Text: sb.String(), Score: score}
+}
 
-	model := NewModel(mng.config, ln.Model, mng.logger)
-	mng.models[ln.Source][ln.Target] = model
+// UnloadModels unloads all the loaded models.
+func (mng *Manager) UnloadModels() error {
+	mng.logger.Info().Msg("unloading all models...")
+	for _, pair := range mng.LanguagePairs() {
+		for _, wm := range pair.Models {
+			if wm.Model.Status() != StatusLoaded {
+				continue
+			}
+			if err := wm.Model.Unload(); err != nil {
+				return err
+			}
+		}
+	}
+	mng.logger.Info().Msg("all models unloaded successfully")
+	return nil
+}
 
-	return model.Load()
+func sortedStrings(s []string) []string {
+	sort.Strings(s)
+	return s
 }
@@ -0,0 +1,71 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+)
+
+// MemoryKey identifies the translation of a single segment in a
+// TranslationMemory.
+type MemoryKey struct {
+	// Source is the source language.
+	Source string `json:"source"`
+	// Target is the target language.
+	Target string `json:"target"`
+	// Model is the name of the model which performed the translation.
+	Model string `json:"model"`
+	// ModelVersion is the configured version of the model.
+	ModelVersion string `json:"model_version"`
+	// Generation describes the generation parameters configured for the
+	// model (see Model.GenerationParams), so that changing them discards
+	// the translations obtained with the previous ones.
+	Generation string `json:"generation"`
+	// Segment is the source segment.
+	Segment string `json:"segment"`
+}
+
+// memoryKey returns the MemoryKey of the translation of a segment with the
+// given model and the default generation parameters. It reports false if
+// the translation must not be stored, since sampling is configured for the
+// model, and each sampled translation is expected to be different.
+func memoryKey(source, target string, model *Model, segment string) (MemoryKey, bool) {
+	generation := model.GenerationParams()
+	if generation.Temperature > 0 {
+		return MemoryKey{}, false
+	}
+	return MemoryKey{
+		Source:       source,
+		Target:       target,
+		Model:        model.Name(),
+		ModelVersion: model.Version(),
+		Generation:   fmt.Sprintf("%+v", generation),
+		Segment:      segment,
+	}, true
+}
+
+// TranslationMemory is a persistent store of translated segments.
+//
+// The Manager consults it for each segment before running a Model, and
+// stores there the new translations. It is only used with the default
+// generation parameters, when no alternatives are requested, and when
+// sampling is not configured for the model.
+type TranslationMemory interface {
+	// Get returns the translation stored for the given key, reporting
+	// whether it was found.
+	Get(key MemoryKey) (Translation, bool, error)
+	// Put stores the translation for the given key.
+	Put(key MemoryKey, translation Translation) error
+}
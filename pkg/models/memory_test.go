@@ -0,0 +1,50 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryKey(t *testing.T) {
+	t.Parallel()
+
+	config := &configuration.Config{}
+	lm := configuration.LanguageModel{Source: "en", Target: "it", Model: "en-it", Version: "1"}
+	model := NewModel(config, lm, zerolog.Nop())
+
+	key, ok := memoryKey("en", "it", model, "Hello.")
+	require.True(t, ok)
+	assert.Equal(t, "en-it", key.Model)
+	assert.Equal(t, "1", key.ModelVersion)
+	assert.Equal(t, "Hello.", key.Segment)
+
+	lm.Generation = configuration.GenerationParams{NumBeams: 2}
+	model.reconfigure(lm)
+	beamsKey, ok := memoryKey("en", "it", model, "Hello.")
+	require.True(t, ok)
+	assert.NotEqual(t, key, beamsKey, "the generation parameters changed without a new version")
+	assert.Equal(t, key.ModelVersion, beamsKey.ModelVersion)
+
+	lm.Generation = configuration.GenerationParams{Temperature: 0.7}
+	model.reconfigure(lm)
+	_, ok = memoryKey("en", "it", model, "Hello.")
+	assert.False(t, ok, "sampled translations must not be stored")
+}
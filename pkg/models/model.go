@@ -16,10 +16,15 @@ package models
 
 import (
 	"fmt"
+	"math/rand"
 	"os"
 	"path"
+	"runtime"
+	"sync"
+	"time"
 
 	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/SpecializedGeneralist/translator/pkg/metrics"
 	"github.com/SpecializedGeneralist/translator/pkg/osutils"
 	"github.com/nlpodyssey/spago/pkg/ml/ag"
 	"github.com/nlpodyssey/spago/pkg/ml/nn"
@@ -34,33 +39,214 @@ import (
 // Model provides high-level functionalities for loading spaGO models and
 // performing automatic translation.
 type Model struct {
-	config    *configuration.Config
-	name      string
-	model     nn.Model
-	tokenizer *sentencepiece.Tokenizer
-	logger    zerolog.Logger
+	config     *configuration.Config
+	name       string
+	version    string
+	generation configuration.GenerationParams
+	model      nn.Model
+	tokenizer  *sentencepiece.Tokenizer
+	logger     zerolog.Logger
+	// batcher collects concurrent translations into batches. It is nil if
+	// batching is disabled.
+	batcher *batcher
+	// loadMu serializes loading operations.
+	loadMu sync.Mutex
+	// mu protects model, tokenizer, status, stage, stageStart, version and
+	// generation.
+	mu     sync.RWMutex
+	status Status
+	// stage is the current stage of the loading, while the model is being
+	// loaded, and stageStart is when it started.
+	stage      LoadStage
+	stageStart time.Time
 }
 
-// NewModel creates a new Model.
-func NewModel(config *configuration.Config, name string, logger zerolog.Logger) *Model {
-	return &Model{
-		config:    config,
-		name:      name,
-		model:     nil,
-		tokenizer: nil,
-		logger:    logger.With().Str("model", name).Logger(),
+// Status describes the loading state of a Model.
+type Status string
+
+const (
+	// StatusNotLoaded is the status of a Model not yet loaded.
+	StatusNotLoaded Status = "not_loaded"
+	// StatusLoading is the status of a Model while it is being loaded
+	// (including download and conversion, if necessary).
+	StatusLoading Status = "loading"
+	// StatusLoaded is the status of a Model ready to perform translations.
+	StatusLoaded Status = "loaded"
+	// StatusFailed is the status of a Model whose loading failed.
+	StatusFailed Status = "failed"
+)
+
+// LoadStage describes a stage of the loading of a Model.
+type LoadStage string
+
+const (
+	// LoadStageDownloading is the stage where the model files are being
+	// downloaded from Hugging Face models hub.
+	LoadStageDownloading LoadStage = "downloading"
+	// LoadStageConverting is the stage where the downloaded model is being
+	// converted to a spaGO model.
+	LoadStageConverting LoadStage = "converting"
+	// LoadStageLoading is the stage where the spaGO model and the tokenizer
+	// are being loaded into memory.
+	LoadStageLoading LoadStage = "loading"
+)
+
+// LoadProgress describes the progress of the loading of a Model.
+type LoadProgress struct {
+	// Stage is the current loading stage.
+	Stage LoadStage
+	// StageStart is when the current stage started.
+	StageStart time.Time
+	// DownloadedBytes is the amount of model files data downloaded so far,
+	// during LoadStageDownloading.
+	DownloadedBytes int64
+}
+
+// NewModel creates a new Model for the given language model configuration.
+func NewModel(config *configuration.Config, lm configuration.LanguageModel, logger zerolog.Logger) *Model {
+	m := &Model{
+		config:     config,
+		name:       lm.Model,
+		version:    lm.Version,
+		generation: lm.Generation,
+		model:      nil,
+		tokenizer:  nil,
+		logger:     logger.With().Str("model", lm.Model).Logger(),
+		status:     StatusNotLoaded,
+	}
+	m.batcher = newBatcher(config.Batching, m.generate)
+	return m
+}
+
+// Name returns the name of the model.
+func (m *Model) Name() string {
+	return m.name
+}
+
+// Version returns the configured version of the model, which can be empty.
+func (m *Model) Version() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.version
+}
+
+// GenerationParams returns the generation parameters from the language
+// model configuration, which override the defaults of the model.
+func (m *Model) GenerationParams() configuration.GenerationParams {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.generation
+}
+
+// reconfigure updates the version and the generation parameters of the
+// model from a new language model configuration for the same model name.
+func (m *Model) reconfigure(lm configuration.LanguageModel) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.version = lm.Version
+	m.generation = lm.Generation
+}
+
+// Path returns the local path of the model.
+func (m *Model) Path() string {
+	return path.Join(m.config.ModelsPath, m.name)
+}
+
+// estimatedSize returns the size of the spaGO model file, as an estimate
+// of the memory used by the loaded model. It is zero if the file does not
+// exist (yet).
+func (m *Model) estimatedSize() int64 {
+	info, err := os.Stat(path.Join(m.Path(), defaultSpagoModelFilename))
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// Status returns the current loading status of the model.
+func (m *Model) Status() Status {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.status
+}
+
+func (m *Model) setStatus(status Status) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.status = status
+}
+
+// LoadProgress returns the progress of the loading of the model. It
+// reports false if the model is not being loaded.
+func (m *Model) LoadProgress() (LoadProgress, bool) {
+	m.mu.RLock()
+	progress := LoadProgress{Stage: m.stage, StageStart: m.stageStart}
+	loading := m.status == StatusLoading
+	m.mu.RUnlock()
+
+	if !loading || progress.Stage == "" {
+		return LoadProgress{}, false
+	}
+	if progress.Stage == LoadStageDownloading {
+		progress.DownloadedBytes = dirSize(m.Path())
+	}
+	return progress, true
+}
+
+func (m *Model) setStage(stage LoadStage) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stage = stage
+	m.stageStart = time.Now()
+}
+
+// dirSize returns the total size of the files in a directory (including
+// partially downloaded ones), ignoring any error.
+func dirSize(dir string) int64 {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0
+	}
+	var size int64
+	for _, entry := range entries {
+		if info, err := entry.Info(); err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
 	}
+	return size
+}
+
+// BARTConfig returns the configuration of the underlying BART model.
+// It reports false if the model is not loaded.
+func (m *Model) BARTConfig() (bartconfig.Config, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.model == nil {
+		return bartconfig.Config{}, false
+	}
+	return m.model.(*conditionalgeneration.Model).BART.Config, true
 }
 
 // Load loads the underlying spaGO model.
 // If the model path is not found, automatic download and conversion
 // are performed using spaGO huggingface Downloader and Converter.
-func (m *Model) Load() error {
-	if m.model != nil {
+func (m *Model) Load() (err error) {
+	m.loadMu.Lock()
+	defer m.loadMu.Unlock()
+
+	if m.Status() == StatusLoaded {
 		return fmt.Errorf("model already loaded")
 	}
 
-	err := m.downloadSpagoModelIfMissing()
+	m.setStatus(StatusLoading)
+	defer func() {
+		m.setStage("")
+		if err != nil {
+			m.setStatus(StatusFailed)
+		}
+	}()
+
+	err = m.downloadSpagoModelIfMissing()
 	if err != nil {
 		return err
 	}
@@ -71,42 +257,166 @@ func (m *Model) Load() error {
 	}
 
 	m.logger.Info().Msg("loading model...")
+	m.setStage(LoadStageLoading)
 
-	modelPath := path.Join(m.config.ModelsPath, m.name)
-	m.model, err = loader.Load(modelPath)
+	modelPath := m.Path()
+	model, err := loader.Load(modelPath)
 	if err != nil {
 		return err
 	}
 
 	m.logger.Info().Msg("loading tokenizer...")
 
-	m.tokenizer, err = sentencepiece.NewFromModelFolder(modelPath, false)
+	tokenizer, err := sentencepiece.NewFromModelFolder(modelPath, false)
 	if err != nil {
 		return err
 	}
 
+	m.mu.Lock()
+	m.model = model
+	m.tokenizer = tokenizer
+	m.status = StatusLoaded
+	m.mu.Unlock()
+
 	m.logger.Info().Msg("model and tokenizer loaded successfully")
 	return nil
 }
 
+// Translation is a translated text, together with its score.
+type Translation struct {
+	// Text is the translated text.
+	Text string
+	// Score is the average log-probability of the tokens of the translated
+	// text (normalized according to the length penalty): the higher, the
+	// more confident the model is about the translation.
+	Score float64
+}
+
 // Translate performs automatic translation of the given text.
-func (m *Model) Translate(text string) string {
-	g := ag.NewGraph(ag.IncrementalForward(false), ag.ConcurrentComputations(1))
-	defer g.Clear()
+// It returns an error if the model is not loaded.
+//
+// The non-zero generation parameters override the ones from the language
+// model configuration, which in turn override the defaults of the model.
+func (m *Model) Translate(text string, params configuration.GenerationParams) (string, error) {
+	translations, err := m.TranslateAlternatives(text, params, 1)
+	if err != nil {
+		return "", err
+	}
+	return translations[0].Text, nil
+}
 
-	proc := nn.ReifyForInference(m.model, g).(*conditionalgeneration.Model)
-	bartConfig := proc.BART.Config
+// TranslateAlternatives performs automatic translation of the given text,
+// returning up to n alternative translations, sorted by descending score.
+// It returns an error if the model is not loaded.
+//
+// With beam search, the number of beams is raised to n, if necessary.
+// With sampling, n sequences are sampled, and only the distinct ones are
+// returned.
+//
+// The generation parameters are handled as described for Translate.
+//
+// If batching is enabled, the translation is computed together with the
+// other translations submitted to the model within the configured window.
+func (m *Model) TranslateAlternatives(text string, params configuration.GenerationParams, n int) ([]Translation, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.model == nil {
+		return nil, fmt.Errorf("model %#v is not loaded", m.name)
+	}
+	if n < 1 {
+		n = 1
+	}
+
+	bartConfig := m.model.(*conditionalgeneration.Model).BART.Config
 
 	tokens := m.tokenizer.Tokenize(text)
 	tokenIDs := m.tokenizer.TokensToIDs(tokens)
 
 	tokenIDs = append(tokenIDs, bartConfig.EosTokenID)
 
-	rawGeneratedIDs := proc.Generate(tokenIDs)
-	generatedIDs := stripBadTokens(rawGeneratedIDs, bartConfig)
+	params = defaultGenerationParams(bartConfig).Override(m.generation).Override(params)
+	if params.NumBeams < n {
+		params.NumBeams = n
+	}
+	input := generationInput{
+		inputIDs: tokenIDs,
+		params:   params,
+		n:        n,
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	var hypotheses []hypothesis
+	if m.batcher != nil {
+		hypotheses = m.batcher.submit(input)
+	} else {
+		hypotheses = m.generate([]generationInput{input})[0]
+	}
 
-	generatedTokens := m.tokenizer.IDsToTokens(generatedIDs)
-	return m.tokenizer.Detokenize(generatedTokens)
+	metrics.InputTokens.WithLabelValues(m.name).Add(float64(len(tokenIDs)))
+
+	translations := make([]Translation, len(hypotheses))
+	for i, hyp := range hypotheses {
+		generatedIDs := stripBadTokens(hyp.tokenIDs, bartConfig)
+		if i == 0 {
+			metrics.OutputTokens.WithLabelValues(m.name).Add(float64(len(generatedIDs)))
+		}
+		generatedTokens := m.tokenizer.IDsToTokens(generatedIDs)
+		translations[i] = Translation{
+			Text:  m.tokenizer.Detokenize(generatedTokens),
+			Score: hyp.score,
+		}
+	}
+	return translations, nil
+}
+
+// generate computes a batch of generation inputs with a single graph,
+// returning the hypotheses of each input. It must be called with mu held
+// (for reading) and the model loaded.
+func (m *Model) generate(inputs []generationInput) [][]hypothesis {
+	concurrency := len(inputs)
+	if max := runtime.GOMAXPROCS(0); concurrency > max {
+		concurrency = max
+	}
+	g := ag.NewGraph(ag.IncrementalForward(false), ag.ConcurrentComputations(concurrency))
+	defer g.Clear()
+
+	proc := nn.ReifyForInference(m.model, g).(*conditionalgeneration.Model)
+	bartConfig := proc.BART.Config
+
+	requests := make([]generationRequest, len(inputs))
+	for i, in := range inputs {
+		requests[i] = generationRequest{
+			generator: newGenerator(proc, bartConfig, in.params, in.rand),
+			inputIDs:  in.inputIDs,
+			n:         in.n,
+		}
+	}
+	if m.batcher != nil {
+		metrics.BatchSize.WithLabelValues(m.name).Observe(float64(len(inputs)))
+	}
+	return generateBatch(requests)
+}
+
+// Unload releases the resources of the underlying spaGO model.
+// In-progress translations are completed before the model is released.
+func (m *Model) Unload() error {
+	m.loadMu.Lock()
+	defer m.loadMu.Unlock()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.model == nil {
+		return fmt.Errorf("model not loaded")
+	}
+
+	m.model.(*conditionalgeneration.Model).Close()
+	m.model = nil
+	m.tokenizer = nil
+	m.status = StatusNotLoaded
+
+	m.logger.Info().Msg("model unloaded")
+	return nil
 }
 
 func stripBadTokens(ids []int, bcfg bartconfig.Config) []int {
@@ -137,6 +447,7 @@ func (m *Model) downloadSpagoModelIfMissing() error {
 
 func (m *Model) downloadSpagoModel() error {
 	m.logger.Info().Msg("downloading model from Hugging Face models hub...")
+	m.setStage(LoadStageDownloading)
 
 	modelsPath := m.config.ModelsPath
 	modelsPathExists, err := osutils.DirExists(modelsPath)
@@ -182,6 +493,7 @@ func (m *Model) convertModelIfNecessary() error {
 
 func (m *Model) convertModel() error {
 	m.logger.Info().Msg("converting model...")
+	m.setStage(LoadStageConverting)
 	converter := huggingface.NewConverter(m.config.ModelsPath, m.name)
 	err := converter.Convert()
 	if err != nil {
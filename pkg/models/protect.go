@@ -0,0 +1,195 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TranslateOptions provides optional settings for a single translation
+// performed by the Manager.
+type TranslateOptions struct {
+	// DoNotTranslate are terms which must be kept verbatim wherever they
+	// occur, as whole words, in the text.
+	DoNotTranslate []string
+	// Model is the name of a model to use for the translation, instead of
+	// choosing one by weight (see SelectModels).
+	Model string
+}
+
+// maskSpan is a portion of a text to be replaced with a placeholder before
+// translation. After translation, the placeholder is replaced with the
+// replacement text.
+type maskSpan struct {
+	start, end  int
+	replacement string
+}
+
+// placeholder returns the i-th placeholder of a masked text.
+func placeholder(i int) string {
+	return "__" + strconv.Itoa(i) + "__"
+}
+
+// placeholderRegexp matches the placeholders in a translated text,
+// tolerating the spaces which may be inserted by the models.
+var placeholderRegexp = regexp.MustCompile(`_\s*_\s*(\d+)\s*_\s*_`)
+
+// maskText replaces the given spans of the text with placeholders. It
+// returns the masked text, and the replacement of each placeholder, in
+// order.
+//
+// When spans overlap, the leftmost one is used, then the longest one, then
+// the first one in the given order.
+//
+// Any text which already looks like a placeholder (such as "__1__" in
+// Markdown) is masked as well, and restored verbatim, so that unmaskText
+// cannot mistake it for one of the actual placeholders.
+func maskText(text string, spans []maskSpan) (string, []string) {
+	if len(spans) == 0 {
+		return text, nil
+	}
+
+	sorted := make([]maskSpan, len(spans))
+	copy(sorted, spans)
+	for _, loc := range placeholderRegexp.FindAllStringIndex(text, -1) {
+		sorted = append(sorted, maskSpan{start: loc[0], end: loc[1], replacement: text[loc[0]:loc[1]]})
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].start != sorted[j].start {
+			return sorted[i].start < sorted[j].start
+		}
+		return sorted[i].end > sorted[j].end
+	})
+
+	var sb strings.Builder
+	var replacements []string
+	last := 0
+	for _, span := range sorted {
+		if span.start < last {
+			continue
+		}
+		sb.WriteString(text[last:span.start])
+		sb.WriteString(placeholder(len(replacements)))
+		replacements = append(replacements, span.replacement)
+		last = span.end
+	}
+	sb.WriteString(text[last:])
+	return sb.String(), replacements
+}
+
+// unmaskText replaces the placeholders in a translated text with their
+// replacements, as returned by maskText.
+//
+// It reports false if any placeholder is missing from the text, or occurs
+// more than once, since the models may drop or repeat them.
+func unmaskText(text string, replacements []string) (string, bool) {
+	if len(replacements) == 0 {
+		return text, true
+	}
+	counts := make([]int, len(replacements))
+	unmasked := placeholderRegexp.ReplaceAllStringFunc(text, func(s string) string {
+		i, err := strconv.Atoi(placeholderRegexp.FindStringSubmatch(s)[1])
+		if err != nil || i >= len(replacements) {
+			return s
+		}
+		counts[i]++
+		return replacements[i]
+	})
+	for _, count := range counts {
+		if count != 1 {
+			return unmasked, false
+		}
+	}
+	return unmasked, true
+}
+
+// translateMasked translates a segment with translate, after replacing the
+// given spans with placeholders (see maskText), which are restored in the
+// translations.
+//
+// The alternative translations which do not contain each placeholder
+// exactly once are discarded, since a protected span would be lost or
+// duplicated. If none is left, the segment is translated again without
+// placeholders: the spans are then left to the models, like any other text.
+func (mng *Manager) translateMasked(
+	segment string,
+	spans []maskSpan,
+	translate func(string) ([]Translation, error),
+) ([]Translation, error) {
+	masked, replacements := maskText(segment, spans)
+	translations, err := translate(masked)
+	if err != nil || len(replacements) == 0 {
+		return translations, err
+	}
+
+	var kept []Translation
+	for _, t := range translations {
+		text, ok := unmaskText(t.Text, replacements)
+		if ok {
+			kept = append(kept, Translation{Text: text, Score: t.Score})
+		}
+	}
+	if len(kept) > 0 {
+		return kept, nil
+	}
+
+	mng.logger.Warn().Int("placeholders", len(replacements)).Msg("placeholders lost in translation: translating the segment without them")
+	return translate(segment)
+}
+
+// termsSpans returns the spans of all the occurrences of the given terms
+// in the text, as whole words. Each span is restored verbatim.
+func termsSpans(text string, terms []string) []maskSpan {
+	var spans []maskSpan
+	for _, term := range terms {
+		if term == "" {
+			continue
+		}
+		for offset := 0; offset < len(text); {
+			i := strings.Index(text[offset:], term)
+			if i == -1 {
+				break
+			}
+			start := offset + i
+			end := start + len(term)
+			if isWordBoundary(text, start) && isWordBoundary(text, end) {
+				spans = append(spans, maskSpan{start: start, end: end, replacement: term})
+			}
+			_, size := utf8.DecodeRuneInString(text[start:])
+			offset = start + size
+		}
+	}
+	return spans
+}
+
+// isWordBoundary reports whether the given position of the text is not
+// between two word characters (letters, digits or underscores).
+func isWordBoundary(text string, pos int) bool {
+	if pos == 0 || pos == len(text) {
+		return true
+	}
+	before, _ := utf8.DecodeLastRuneInString(text[:pos])
+	after, _ := utf8.DecodeRuneInString(text[pos:])
+	return !isWordChar(before) || !isWordChar(after)
+}
+
+func isWordChar(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,198 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityDetector(t *testing.T) {
+	t.Parallel()
+
+	d, err := newEntityDetector(configuration.EntityProtection{Enabled: true})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		text     string
+		expected []string
+	}{
+		{"See https://example.com/a?b=c, or www.example.org.", []string{"https://example.com/a?b=c", "www.example.org"}},
+		{"Write to john.doe@example.co.uk today", []string{"john.doe@example.co.uk"}},
+		{"Follow @acme_corp and #GoLang, not email#tag", []string{"@acme_corp", "#GoLang"}},
+		{"It weighs 2.5 kg and costs $1,200 or 30% off at 5GHz", []string{"2.5 kg", "$1,200", "30%", "5GHz"}},
+		{"We have 5 mice and 3 apples", nil},
+		{"Call parse_args() or getValue in main.go", []string{"parse_args()", "getValue", "main.go"}},
+		{"Nothing special here.", nil},
+	}
+	for _, tc := range testCases {
+		masked, replacements := maskText(tc.text, d.spans(tc.text))
+		assert.Equal(t, tc.expected, replacements, tc.text)
+		unmasked, ok := unmaskText(masked, replacements)
+		assert.True(t, ok, tc.text)
+		assert.Equal(t, tc.text, unmasked, tc.text)
+	}
+
+	_, err = newEntityDetector(configuration.EntityProtection{Enabled: true, Entities: []string{"foo"}})
+	assert.Error(t, err)
+
+	d, err = newEntityDetector(configuration.EntityProtection{})
+	require.NoError(t, err)
+	assert.Nil(t, d)
+	assert.Nil(t, d.spans("https://example.com"))
+}
+
+func TestMaskText(t *testing.T) {
+	t.Parallel()
+
+	text := "Ask ACME Corp about ACME Corporation"
+	spans := termsSpans(text, []string{"ACME Corp", "ACME"})
+	spans = append(spans, maskSpan{start: 4, end: 8, replacement: "Acme"})
+
+	masked, replacements := maskText(text, spans)
+	assert.Equal(t, "Ask __0__ about __1__ Corporation", masked)
+	assert.Equal(t, []string{"ACME Corp", "ACME"}, replacements)
+
+	unmasked, ok := unmaskText("Chiedi ad __ 0 __ di __1__ Corporation __7__", replacements)
+	assert.True(t, ok)
+	assert.Equal(t, "Chiedi ad ACME Corp di ACME Corporation __7__", unmasked)
+}
+
+func TestMaskTextLiteralPlaceholders(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		text             string
+		terms            []string
+		wantMasked       string
+		wantReplacements []string
+		// translated is the output of the model for the masked text.
+		translated string
+		want       string
+	}{
+		{
+			text:             "Use __0__ with ACME",
+			terms:            []string{"ACME"},
+			wantMasked:       "Use __0__ with __1__",
+			wantReplacements: []string{"__0__", "ACME"},
+			translated:       "Con __1__, usa __0__",
+			want:             "Con ACME, usa __0__",
+		},
+		{
+			text:             "ACME says __1__ and _ _ 0 _ _",
+			terms:            []string{"ACME"},
+			wantMasked:       "__0__ says __1__ and __2__",
+			wantReplacements: []string{"ACME", "__1__", "_ _ 0 _ _"},
+			translated:       "__0__ dice __ 1 __ e __2__",
+			want:             "ACME dice __1__ e _ _ 0 _ _",
+		},
+		{
+			text:       "Only __0__ here",
+			wantMasked: "Only __0__ here",
+			translated: "Solo __0__ qui",
+			want:       "Solo __0__ qui",
+		},
+	}
+	for _, tc := range testCases {
+		masked, replacements := maskText(tc.text, termsSpans(tc.text, tc.terms))
+		assert.Equal(t, tc.wantMasked, masked, tc.text)
+		assert.Equal(t, tc.wantReplacements, replacements, tc.text)
+		unmasked, ok := unmaskText(tc.translated, replacements)
+		assert.True(t, ok, tc.text)
+		assert.Equal(t, tc.want, unmasked, tc.text)
+	}
+}
+
+func TestUnmaskTextLostPlaceholders(t *testing.T) {
+	t.Parallel()
+
+	replacements := []string{"ACME", "https://example.com"}
+	testCases := []struct {
+		translated string
+		want       string
+		wantOK     bool
+	}{
+		{"Visita __1__ di __0__", "Visita https://example.com di ACME", true},
+		{"Visita il sito di __0__", "Visita il sito di ACME", false},
+		{"Visita __1__ di __0__ e __0__", "Visita https://example.com di ACME e ACME", false},
+		{"Visita il sito", "Visita il sito", false},
+	}
+	for _, tc := range testCases {
+		unmasked, ok := unmaskText(tc.translated, replacements)
+		assert.Equal(t, tc.wantOK, ok, tc.translated)
+		assert.Equal(t, tc.want, unmasked, tc.translated)
+	}
+}
+
+func TestTranslateMasked(t *testing.T) {
+	t.Parallel()
+
+	mng := NewManager(&configuration.Config{}, zerolog.Nop())
+	text := "Write to ACME at info@example.com"
+	spans := termsSpans(text, []string{"ACME"})
+	email := strings.Index(text, "info@")
+	spans = append(spans, maskSpan{start: email, end: len(text), replacement: "info@example.com"})
+
+	testCases := []struct {
+		name string
+		// translations are the outputs of the model for each input text.
+		translations map[string][]Translation
+		want         []Translation
+	}{
+		{
+			name: "placeholders restored",
+			translations: map[string][]Translation{
+				"Write to __0__ at __1__": {{Text: "Scrivi a __0__ all'indirizzo __1__", Score: -1}},
+			},
+			want: []Translation{{Text: "Scrivi a ACME all'indirizzo info@example.com", Score: -1}},
+		},
+		{
+			name: "alternatives dropping a placeholder are discarded",
+			translations: map[string][]Translation{
+				"Write to __0__ at __1__": {
+					{Text: "Scrivi a __0__", Score: -1},
+					{Text: "Scrivi a __0__ presso __1__", Score: -2},
+				},
+			},
+			want: []Translation{{Text: "Scrivi a ACME presso info@example.com", Score: -2}},
+		},
+		{
+			name: "the model drops a placeholder",
+			translations: map[string][]Translation{
+				"Write to __0__ at __1__":           {{Text: "Scrivi a __0__", Score: -1}},
+				"Write to ACME at info@example.com": {{Text: "Scrivi ad ACME a info@example.com", Score: -3}},
+			},
+			want: []Translation{{Text: "Scrivi ad ACME a info@example.com", Score: -3}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			translations, err := mng.translateMasked(text, spans, func(s string) ([]Translation, error) {
+				translations, ok := tc.translations[s]
+				require.True(t, ok, s)
+				return translations, nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, translations)
+		})
+	}
+}
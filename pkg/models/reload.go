@@ -0,0 +1,178 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+)
+
+// Reload applies a new configuration, while translations keep being
+// served.
+//
+// The language models of the new configuration are compared with the ones
+// currently loaded: models are kept if the same model name is still
+// configured for the same language pair (with updated weight, version and
+// generation parameters), and the other models are loaded. Then, the new models,
+// language aliases, glossaries and settings are swapped in at once, and the
+// models which are no longer used are unloaded, as soon as the requests
+// using them (see SelectRoute) are completed.
+//
+// The glossaries of the new configuration replace all the current ones,
+// including the ones set with SetGlossary.
+//
+// If the new configuration is invalid (including its glossaries), or any
+// model fails to load, the current configuration is left in place.
+//
+// With lazy loading, the new models are not loaded immediately, but on
+// first use.
+func (mng *Manager) Reload(config *configuration.Config) error {
+	mng.loadMu.Lock()
+	defer mng.loadMu.Unlock()
+
+	mng.logger.Info().Msg("reloading configuration...")
+	if err := mng.apply(config); err != nil {
+		return err
+	}
+	mng.logger.Info().Msg("configuration reloaded successfully")
+	return nil
+}
+
+// apply loads the models of a configuration, reusing the current ones
+// where possible, then swaps them in, as described for Reload.
+// It must be called with loadMu locked.
+func (mng *Manager) apply(config *configuration.Config) error {
+	aliases, err := newLanguageAliases(config.LanguageModels)
+	if err != nil {
+		return err
+	}
+	entities, err := newEntityDetector(config.EntityProtection)
+	if err != nil {
+		return err
+	}
+
+	mng.mu.RLock()
+	current := mng.models
+	mng.mu.RUnlock()
+
+	type reusedModel struct {
+		model *Model
+		lm    configuration.LanguageModel
+	}
+	var reused []reusedModel
+	var added []*Model
+	kept := make(map[*Model]struct{})
+
+	models := make(modelsMap, len(current))
+	for _, lm := range config.LanguageModels {
+		source := NormalizeLanguage(lm.Source)
+		target := NormalizeLanguage(lm.Target)
+
+		if _, ok := models[source]; !ok {
+			models[source] = make(map[string][]WeightedModel, 1)
+		}
+		for _, wm := range models[source][target] {
+			if wm.Model.Name() == lm.Model {
+				return fmt.Errorf("model %#v is configured twice for translation from %#v to %#v", lm.Model, source, target)
+			}
+		}
+
+		model := findModel(current[source][target], lm.Model)
+		if model != nil {
+			reused = append(reused, reusedModel{model: model, lm: lm})
+			kept[model] = struct{}{}
+		} else {
+			model = NewModel(config, lm, mng.logger)
+			added = append(added, model)
+		}
+		models[source][target] = append(models[source][target], WeightedModel{Model: model, Weight: lm.Weight})
+	}
+
+	if err = checkFallbacks(config.Fallbacks, models, aliases); err != nil {
+		return err
+	}
+	glossaries, err := configGlossaries(config, models, aliases)
+	if err != nil {
+		return err
+	}
+
+	if mng.resident == nil {
+		for _, model := range added {
+			if err = model.Load(); err != nil {
+				mng.unloadAll(added)
+				return err
+			}
+		}
+	}
+	for _, r := range reused {
+		r.model.reconfigure(r.lm)
+	}
+
+	mng.mu.Lock()
+	mng.config = config
+	mng.models = models
+	mng.aliases = aliases
+	mng.entities = entities
+	mng.glossaries.replace(glossaries)
+	mng.mu.Unlock()
+
+	var removed []*Model
+	for _, targets := range current {
+		for _, pairModels := range targets {
+			for _, wm := range pairModels {
+				if _, ok := kept[wm.Model]; !ok {
+					removed = append(removed, wm.Model)
+				}
+			}
+		}
+	}
+	idle := mng.users.retire(removed)
+	for _, model := range removed {
+		mng.resident.forget(model)
+	}
+	mng.users.unload(idle)
+	mng.logGlossaries(glossaries)
+
+	if len(current) > 0 {
+		mng.logger.Info().Int("added", len(added)).Int("removed", len(removed)).
+			Int("kept", len(reused)).Msg("language models updated")
+	}
+	return nil
+}
+
+// findModel returns the model with the given name among the given ones, or
+// nil if it is not found.
+func findModel(models []WeightedModel, name string) *Model {
+	for _, wm := range models {
+		if wm.Model.Name() == name {
+			return wm.Model
+		}
+	}
+	return nil
+}
+
+// unloadAll unloads the loaded models among the given ones, logging any
+// error.
+func (mng *Manager) unloadAll(models []*Model) {
+	for _, model := range models {
+		if model.Status() != StatusLoaded {
+			continue
+		}
+		if err := model.Unload(); err != nil {
+			mng.logger.Err(err).Str("model", model.Name()).Msg("error unloading model")
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagerReload(t *testing.T) {
+	t.Parallel()
+
+	mng := newRouteTestManager(configuration.PivotTranslation{},
+		[2]string{"en", "it"}, [2]string{"it", "en"})
+	enIt, ok := mng.GetModel("en", "it")
+	require.True(t, ok)
+
+	config := &configuration.Config{
+		LanguageModels: []configuration.LanguageModel{
+			{
+				Source:        "en",
+				Target:        "it",
+				TargetAliases: []string{"italian"},
+				Model:         "en-it",
+				Version:       "2",
+			},
+		},
+		Glossaries: []configuration.Glossary{
+			{Source: "en", Target: "italian", Terms: []configuration.GlossaryTerm{{Source: "a", Target: "b"}}},
+		},
+	}
+	require.NoError(t, mng.Reload(config))
+
+	assert.Same(t, config, mng.Config())
+
+	model, ok := mng.GetModel("en", "italian")
+	require.True(t, ok)
+	assert.Same(t, enIt, model)
+	assert.Equal(t, "2", model.Version())
+
+	_, ok = mng.GetModel("it", "en")
+	assert.False(t, ok)
+	assert.Len(t, mng.LanguagePairs(), 1)
+	assert.Equal(t, 1, mng.Glossaries().Len("en", "it"))
+
+	invalid := &configuration.Config{
+		LanguageModels: []configuration.LanguageModel{
+			{Source: "en", Target: "it", Model: "en-it"},
+			{Source: "EN", Target: "IT", Model: "en-it"},
+		},
+	}
+	assert.Error(t, mng.Reload(invalid))
+	assert.Same(t, config, mng.Config())
+}
+
+func TestManagerReloadGlossaries(t *testing.T) {
+	t.Parallel()
+
+	mng := newRouteTestManager(configuration.PivotTranslation{},
+		[2]string{"en", "it"}, [2]string{"it", "en"})
+	models := []configuration.LanguageModel{
+		{Source: "en", Target: "it", Model: "en-it"},
+		{Source: "it", Target: "en", Model: "it-en"},
+	}
+	terms := []configuration.GlossaryTerm{{Source: "a", Target: "b"}}
+
+	config := &configuration.Config{
+		LanguageModels: models,
+		Glossaries: []configuration.Glossary{
+			{Source: "en", Target: "it", Terms: terms},
+			{Source: "it", Target: "en", Terms: terms},
+		},
+	}
+	require.NoError(t, mng.Reload(config))
+	assert.Equal(t, 1, mng.Glossaries().Len("en", "it"))
+	assert.Equal(t, 1, mng.Glossaries().Len("it", "en"))
+
+	t.Run("removed glossaries are cleared", func(t *testing.T) {
+		config = &configuration.Config{
+			LanguageModels: models,
+			Glossaries:     []configuration.Glossary{{Source: "en", Target: "it", Terms: terms}},
+		}
+		require.NoError(t, mng.Reload(config))
+		assert.Equal(t, 1, mng.Glossaries().Len("en", "it"))
+		assert.Equal(t, 0, mng.Glossaries().Len("it", "en"))
+	})
+
+	t.Run("an invalid glossary leaves the current configuration in place", func(t *testing.T) {
+		revision := mng.Glossaries().Revision()
+		invalid := &configuration.Config{
+			LanguageModels: models[:1],
+			Glossaries: []configuration.Glossary{
+				{Source: "en", Target: "it", Terms: []configuration.GlossaryTerm{{Source: "x", Target: "y"}, {Source: "z"}}},
+			},
+		}
+		assert.Error(t, mng.Reload(invalid))
+		assert.Same(t, config, mng.Config())
+		assert.Len(t, mng.LanguagePairs(), 2)
+		assert.Equal(t, revision, mng.Glossaries().Revision())
+
+		invalid.Glossaries = []configuration.Glossary{{Source: "it", Target: "en", Terms: terms}}
+		assert.Error(t, mng.Reload(invalid))
+		assert.Len(t, mng.LanguagePairs(), 2)
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+)
+
+// Route returns the sequence of language pairs to translate from source to
+// target.
+//
+// Language codes are matched as described for Manager.LookupPair.
+// If a model for the pair is available, the route is made of that pair
+// only. Otherwise, if pivot translation is enabled, the route is the
+// shortest chain of pairs through intermediate languages (among the ones
+// allowed by the configuration); ties are broken by preferring the
+// alphabetically lower intermediate languages.
+func (mng *Manager) Route(source, target string) ([]LanguagePair, error) {
+	mng.mu.RLock()
+	defer mng.mu.RUnlock()
+	return mng.route(source, target)
+}
+
+// route implements Route. It must be called with mu locked.
+func (mng *Manager) route(source, target string) ([]LanguagePair, error) {
+	return resolveRoute(mng.config.PivotTranslation, mng.models, mng.aliases, source, target)
+}
+
+// resolveRoute looks for a route among the given models, as described for
+// Route.
+func resolveRoute(
+	pivot configuration.PivotTranslation,
+	models modelsMap,
+	aliases languageAliases,
+	source, target string,
+) ([]LanguagePair, error) {
+	if pair, ok := findPair(models, aliases, source, target); ok {
+		return []LanguagePair{pair}, nil
+	}
+
+	if pivot.Enabled {
+		for _, s := range aliases.candidates(source) {
+			for _, t := range aliases.candidates(target) {
+				route := findRoute(models, s, t, pivot.MaxIntermediateLanguages, false)
+				if route != nil {
+					return route, nil
+				}
+			}
+		}
+	}
+	return nil, fmt.Errorf("no model available for translation from %#v to %#v", source, target)
+}
+
+// IntermediateLanguages returns the intermediate languages of a route, that
+// is the target language of each pair but the last one.
+func IntermediateLanguages(route []LanguagePair) []string {
+	if len(route) < 2 {
+		return nil
+	}
+	languages := make([]string, len(route)-1)
+	for i, pair := range route[:len(route)-1] {
+		languages[i] = pair.Target
+	}
+	return languages
+}
+
+// findRoute performs a breadth-first search of the shortest route from
+// source to target, with at most maxIntermediate intermediate languages
+// (zero means no limit). If skipDirect is true, the direct pair from source
+// to target is not considered. It returns nil if no route is found.
+func findRoute(models modelsMap, source, target string, maxIntermediate int, skipDirect bool) []LanguagePair {
+	// previous maps each visited language to the pair used to reach it.
+	previous := map[string]LanguagePair{source: {}}
+	frontier := []string{source}
+
+	for depth := 0; len(frontier) > 0; depth++ {
+		if maxIntermediate > 0 && depth > maxIntermediate {
+			return nil
+		}
+
+		var next []string
+		for _, lang := range frontier {
+			targets := make([]string, 0, len(models[lang]))
+			for t := range models[lang] {
+				targets = append(targets, t)
+			}
+			sort.Strings(targets)
+
+			for _, t := range targets {
+				if _, visited := previous[t]; visited {
+					continue
+				}
+				if skipDirect && lang == source && t == target {
+					continue
+				}
+				previous[t] = newLanguagePair(lang, t, models[lang][t])
+				if t == target {
+					return buildRoute(previous, source, target)
+				}
+				next = append(next, t)
+			}
+		}
+		frontier = next
+	}
+	return nil
+}
+
+func buildRoute(previous map[string]LanguagePair, source, target string) []LanguagePair {
+	var route []LanguagePair
+	for lang := target; lang != source; {
+		pair := previous[lang]
+		route = append(route, pair)
+		lang = pair.Source
+	}
+	for i, j := 0, len(route)-1; i < j; i, j = i+1, j-1 {
+		route[i], route[j] = route[j], route[i]
+	}
+	return route
+}
@@ -0,0 +1,102 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRouteTestManager(pivot configuration.PivotTranslation, pairs ...[2]string) *Manager {
+	config := &configuration.Config{PivotTranslation: pivot}
+	mng := NewManager(config, zerolog.Nop())
+	for _, p := range pairs {
+		if _, ok := mng.models[p[0]]; !ok {
+			mng.models[p[0]] = make(map[string][]WeightedModel)
+		}
+		lm := configuration.LanguageModel{Source: p[0], Target: p[1], Model: p[0] + "-" + p[1]}
+		mng.models[p[0]][p[1]] = []WeightedModel{{Model: NewModel(config, lm, zerolog.Nop())}}
+	}
+	return mng
+}
+
+func routeModels(route []LanguagePair) []string {
+	names := make([]string, len(route))
+	for i, pair := range route {
+		names[i] = pair.Model.Name()
+	}
+	return names
+}
+
+func TestManagerRoute(t *testing.T) {
+	t.Parallel()
+
+	pairs := [][2]string{
+		{"it", "en"}, {"en", "de"}, {"it", "fr"}, {"fr", "de"},
+		{"de", "nl"}, {"en", "it"},
+	}
+
+	t.Run("direct pair", func(t *testing.T) {
+		t.Parallel()
+		mng := newRouteTestManager(configuration.PivotTranslation{}, pairs...)
+		route, err := mng.Route("it", "en")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"it-en"}, routeModels(route))
+		assert.Empty(t, IntermediateLanguages(route))
+	})
+
+	t.Run("pivot disabled", func(t *testing.T) {
+		t.Parallel()
+		mng := newRouteTestManager(configuration.PivotTranslation{}, pairs...)
+		_, err := mng.Route("it", "de")
+		assert.Error(t, err)
+	})
+
+	t.Run("shortest route", func(t *testing.T) {
+		t.Parallel()
+		mng := newRouteTestManager(configuration.PivotTranslation{Enabled: true}, pairs...)
+
+		route, err := mng.Route("it", "de")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"it-en", "en-de"}, routeModels(route))
+		assert.Equal(t, []string{"en"}, IntermediateLanguages(route))
+
+		route, err = mng.Route("it", "nl")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"it-en", "en-de", "de-nl"}, routeModels(route))
+		assert.Equal(t, []string{"en", "de"}, IntermediateLanguages(route))
+	})
+
+	t.Run("max intermediate languages", func(t *testing.T) {
+		t.Parallel()
+		mng := newRouteTestManager(configuration.PivotTranslation{Enabled: true, MaxIntermediateLanguages: 1}, pairs...)
+
+		_, err := mng.Route("it", "de")
+		assert.NoError(t, err)
+		_, err = mng.Route("it", "nl")
+		assert.Error(t, err)
+	})
+
+	t.Run("no route", func(t *testing.T) {
+		t.Parallel()
+		mng := newRouteTestManager(configuration.PivotTranslation{Enabled: true}, pairs...)
+		_, err := mng.Route("de", "it")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,219 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Segment is a portion of a larger text, usually a single sentence.
+type Segment struct {
+	// Text is the content of the segment, without leading and trailing
+	// white space.
+	Text string
+	// Start is the byte offset of the segment within the original text.
+	Start int
+	// End is the byte offset, within the original text, right after the
+	// last byte of the segment.
+	End int
+}
+
+// SplitSegments splits a text into sentence-like segments.
+//
+// Line breaks always terminate a segment. Within a line, a segment ends
+// after a sentence-terminating punctuation mark (optionally followed by
+// closing quotes or brackets) which is followed by white space, unless
+// the punctuation is part of a known abbreviation or initial, or the next
+// word starts with a lowercase letter.
+//
+// The language is an identifier of the language of the text (such as "en"
+// or "it-IT"), used to select language-specific abbreviations. If the
+// language is not known, only the language-independent rules are applied.
+//
+// White space between segments is not part of any segment: it can be
+// recovered from the original text by means of the segments offsets.
+func SplitSegments(text, language string) []Segment {
+	sp := segmentSplitter{
+		text:          text,
+		abbreviations: abbreviations[baseLanguage(language)],
+		segments:      make([]Segment, 0),
+	}
+	lineStart := 0
+	for lineStart < len(text) {
+		lineEnd := strings.IndexByte(text[lineStart:], '\n')
+		if lineEnd == -1 {
+			lineEnd = len(text)
+		} else {
+			lineEnd += lineStart
+		}
+		sp.splitLine(lineStart, lineEnd)
+		lineStart = lineEnd + 1
+	}
+	return sp.segments
+}
+
+type segmentSplitter struct {
+	text          string
+	abbreviations map[string]struct{}
+	segments      []Segment
+}
+
+func (sp *segmentSplitter) splitLine(start, end int) {
+	text := sp.text
+	segStart := start
+	for i := start; i < end; {
+		r, size := utf8.DecodeRuneInString(text[i:end])
+		terminatorPos := i
+		i += size
+		if !isSentenceTerminator(r) {
+			continue
+		}
+		for i < end {
+			r, size = utf8.DecodeRuneInString(text[i:end])
+			if !isSentenceTerminator(r) && !isClosingPunctuation(r) {
+				break
+			}
+			i += size
+		}
+		if i == end {
+			break
+		}
+		if r, _ = utf8.DecodeRuneInString(text[i:end]); !unicode.IsSpace(r) {
+			continue
+		}
+		if text[terminatorPos] == '.' && sp.isAbbreviation(segStart, terminatorPos) {
+			continue
+		}
+		if startsWithLowercase(text[i:end]) {
+			continue
+		}
+		sp.appendSegment(segStart, i)
+		segStart = i
+	}
+	sp.appendSegment(segStart, end)
+}
+
+// isAbbreviation reports whether the period at position dot terminates
+// an abbreviation, an initial or an ordinal number.
+func (sp *segmentSplitter) isAbbreviation(segStart, dot int) bool {
+	wordStart := dot
+	for wordStart > segStart {
+		r, size := utf8.DecodeLastRuneInString(sp.text[segStart:wordStart])
+		if unicode.IsSpace(r) || isOpeningPunctuation(r) {
+			break
+		}
+		wordStart -= size
+	}
+	word := sp.text[wordStart:dot]
+	if word == "" {
+		return false
+	}
+
+	// Single-letter initials, such as "J. R. R. Tolkien".
+	if r, size := utf8.DecodeRuneInString(word); size == len(word) && unicode.IsUpper(r) {
+		return true
+	}
+
+	// Ordinal numbers, such as the German "3. Oktober".
+	if sp.abbreviations != nil && isDigits(word) {
+		_, ok := sp.abbreviations[ordinalNumbersKey]
+		return ok
+	}
+
+	_, ok := sp.abbreviations[strings.ToLower(word)]
+	return ok
+}
+
+func (sp *segmentSplitter) appendSegment(start, end int) {
+	text := sp.text
+	for start < end {
+		r, size := utf8.DecodeRuneInString(text[start:end])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		start += size
+	}
+	for end > start {
+		r, size := utf8.DecodeLastRuneInString(text[start:end])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		end -= size
+	}
+	if start == end {
+		return
+	}
+	sp.segments = append(sp.segments, Segment{Text: text[start:end], Start: start, End: end})
+}
+
+// startsWithLowercase reports whether the first word after leading white
+// space and opening punctuation starts with a lowercase letter.
+func startsWithLowercase(s string) bool {
+	for _, r := range s {
+		if unicode.IsSpace(r) || isOpeningPunctuation(r) {
+			continue
+		}
+		return unicode.IsLower(r)
+	}
+	return false
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// baseLanguage returns the lowercase primary language subtag of a
+// language identifier (e.g. "pt" from "pt-BR").
+func baseLanguage(language string) string {
+	language = NormalizeLanguage(language)
+	if i := strings.IndexAny(language, "-_"); i != -1 {
+		language = language[:i]
+	}
+	return language
+}
+
+func isSentenceTerminator(r rune) bool {
+	switch r {
+	case '.', '!', '?', '…', '。', '！', '？':
+		return true
+	default:
+		return false
+	}
+}
+
+func isClosingPunctuation(r rune) bool {
+	switch r {
+	case '"', '\'', ')', ']', '»', '«', '”', '’', '“':
+		return true
+	default:
+		return false
+	}
+}
+
+func isOpeningPunctuation(r rune) bool {
+	switch r {
+	case '"', '\'', '(', '[', '«', '»', '“', '‘', '„', '¿', '¡':
+		return true
+	default:
+		return false
+	}
+}
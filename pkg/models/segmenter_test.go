@@ -0,0 +1,105 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSplitSegments(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty text", func(t *testing.T) {
+		t.Parallel()
+		assert.Empty(t, models.SplitSegments("", "en"))
+		assert.Empty(t, models.SplitSegments(" \n\t ", "en"))
+	})
+
+	t.Run("single sentence", func(t *testing.T) {
+		t.Parallel()
+		segments := models.SplitSegments("  Hello world  ", "en")
+		assert.Equal(t, []models.Segment{
+			{Text: "Hello world", Start: 2, End: 13},
+		}, segments)
+	})
+
+	t.Run("sentences and lines", func(t *testing.T) {
+		t.Parallel()
+		text := "Hi! How are you?\n\nI'm fine. (Really.) Bye"
+		segments := models.SplitSegments(text, "en")
+		assert.Equal(t, []models.Segment{
+			{Text: "Hi!", Start: 0, End: 3},
+			{Text: "How are you?", Start: 4, End: 16},
+			{Text: "I'm fine.", Start: 18, End: 27},
+			{Text: "(Really.)", Start: 28, End: 37},
+			{Text: "Bye", Start: 38, End: 41},
+		}, segments)
+		for _, s := range segments {
+			assert.Equal(t, s.Text, text[s.Start:s.End])
+		}
+	})
+
+	t.Run("decimals and missing space", func(t *testing.T) {
+		t.Parallel()
+		segments := models.SplitSegments("It costs 3.50 euros...really", "en")
+		assert.Equal(t, []string{"It costs 3.50 euros...really"}, segmentsTexts(segments))
+	})
+
+	t.Run("quotes", func(t *testing.T) {
+		t.Parallel()
+		segments := models.SplitSegments(`He said "Stop." Then he left. «Ciao!» Fine.`, "en")
+		assert.Equal(t, []string{`He said "Stop."`, "Then he left.", "«Ciao!»", "Fine."}, segmentsTexts(segments))
+	})
+
+	t.Run("initials and lowercase continuation", func(t *testing.T) {
+		t.Parallel()
+		segments := models.SplitSegments("J. R. R. Tolkien wrote it. some say so.", "xx")
+		assert.Equal(t, []string{"J. R. R. Tolkien wrote it. some say so."}, segmentsTexts(segments))
+	})
+
+	t.Run("English abbreviations", func(t *testing.T) {
+		t.Parallel()
+		segments := models.SplitSegments("Dr. Smith met Mr. Brown, e.g. Today. Then Prof. X left.", "en-US")
+		assert.Equal(t, []string{"Dr. Smith met Mr. Brown, e.g. Today.", "Then Prof. X left."}, segmentsTexts(segments))
+	})
+
+	t.Run("Italian abbreviations", func(t *testing.T) {
+		t.Parallel()
+		segments := models.SplitSegments("Il Dott. Rossi è arrivato. Vedi pag. 3 ecc. Grazie.", "it")
+		assert.Equal(t, []string{"Il Dott. Rossi è arrivato.", "Vedi pag. 3 ecc. Grazie."}, segmentsTexts(segments))
+	})
+
+	t.Run("German abbreviations and ordinals", func(t *testing.T) {
+		t.Parallel()
+		segments := models.SplitSegments("Am 3. Oktober kamen z.B. Gäste. Es war schön.", "de")
+		assert.Equal(t, []string{"Am 3. Oktober kamen z.B. Gäste.", "Es war schön."}, segmentsTexts(segments))
+	})
+
+	t.Run("ordinals are not abbreviations in English", func(t *testing.T) {
+		t.Parallel()
+		segments := models.SplitSegments("I counted to 3. Then I stopped.", "en")
+		assert.Equal(t, []string{"I counted to 3.", "Then I stopped."}, segmentsTexts(segments))
+	})
+}
+
+func segmentsTexts(segments []models.Segment) []string {
+	texts := make([]string, len(segments))
+	for i, s := range segments {
+		texts[i] = s.Text
+	}
+	return texts
+}
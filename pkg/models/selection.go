@@ -0,0 +1,73 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// SelectModels returns a copy of the route (see Route), where the Model of
+// each language pair is chosen among its Models.
+//
+// If pin is not empty, the model with that name is chosen for the pairs
+// which have one; it is an error if no pair has it. For the other pairs,
+// a model is chosen randomly, with probability proportional to its weight:
+// models with zero weight are only chosen when pinned, unless no model of
+// the pair has a positive weight, in which case the first one is chosen.
+func SelectModels(route []LanguagePair, pin string) ([]LanguagePair, error) {
+	return selectModels(route, pin, rand.Intn)
+}
+
+// selectModels implements SelectModels, drawing random numbers in [0, n)
+// with intn.
+func selectModels(route []LanguagePair, pin string, intn func(n int) int) ([]LanguagePair, error) {
+	selected := make([]LanguagePair, len(route))
+	pinned := false
+	for i, pair := range route {
+		if model, ok := pair.modelNamed(pin); ok {
+			pair.Model = model
+			pinned = true
+		} else {
+			pair.Model = chooseWeightedModel(pair.Models, intn)
+		}
+		selected[i] = pair
+	}
+	if pin != "" && !pinned {
+		return nil, fmt.Errorf("model %#v is not available for translation from %#v to %#v",
+			pin, route[0].Source, route[len(route)-1].Target)
+	}
+	return selected, nil
+}
+
+// chooseWeightedModel chooses a model randomly, with probability
+// proportional to its weight, as described for SelectModels.
+func chooseWeightedModel(models []WeightedModel, intn func(n int) int) *Model {
+	total := 0
+	for _, wm := range models {
+		total += wm.Weight
+	}
+	if total == 0 {
+		return models[0].Model
+	}
+	x := intn(total)
+	for _, wm := range models {
+		if x < wm.Weight {
+			return wm.Model
+		}
+		x -= wm.Weight
+	}
+	return models[len(models)-1].Model
+}
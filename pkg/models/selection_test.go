@@ -0,0 +1,80 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectModels(t *testing.T) {
+	t.Parallel()
+
+	config := &configuration.Config{
+		PivotTranslation: configuration.PivotTranslation{Enabled: true},
+		LanguageModels: []configuration.LanguageModel{
+			{Source: "it", Target: "en", Model: "it-en"},
+			{Source: "en", Target: "de", Model: "baseline", Weight: 3},
+			{Source: "en", Target: "de", Model: "candidate", Weight: 1},
+			{Source: "en", Target: "de", Model: "shadow"},
+		},
+	}
+	mng := NewManager(config, zerolog.Nop())
+	mng.resident = newTestResidentModels(0, new(int32))
+	require.NoError(t, mng.LoadModels())
+
+	route, err := mng.Route("it", "de")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"it-en", "baseline"}, routeModels(route))
+	assert.Len(t, route[1].Models, 3)
+
+	t.Run("weighted choice", func(t *testing.T) {
+		t.Parallel()
+		for x, expected := range []string{"baseline", "baseline", "baseline", "candidate"} {
+			x := x
+			selected, err := selectModels(route, "", func(n int) int {
+				assert.Equal(t, 4, n)
+				return x
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"it-en", expected}, routeModels(selected))
+		}
+		assert.Equal(t, "baseline", route[1].Model.Name(), "the original route is not modified")
+	})
+
+	t.Run("pinned model", func(t *testing.T) {
+		t.Parallel()
+		selected, err := SelectModels(route, "shadow")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"it-en", "shadow"}, routeModels(selected))
+
+		_, err = SelectModels(route, "unknown")
+		assert.Error(t, err)
+	})
+
+	t.Run("lookup by model name", func(t *testing.T) {
+		t.Parallel()
+		pair, err := mng.LookupModel("en", "de", "candidate")
+		require.NoError(t, err)
+		assert.Equal(t, "candidate", pair.Model.Name())
+
+		_, err = mng.LookupModel("en", "de", "it-en")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// modelUsers counts the requests holding each model, so that the models
+// removed by a reload are unloaded only once no request holds them anymore.
+type modelUsers struct {
+	// unloader unloads a model. It is Model.Unload, replaced in tests.
+	unloader func(*Model) error
+	logger   zerolog.Logger
+
+	// mu protects the fields below.
+	mu     sync.Mutex
+	counts map[*Model]int
+	// retired are the models removed by a reload. Once they are not held
+	// anymore, they are unloaded, and they cannot be held again.
+	retired map[*Model]struct{}
+}
+
+func newModelUsers(logger zerolog.Logger) *modelUsers {
+	return &modelUsers{
+		unloader: (*Model).Unload,
+		logger:   logger,
+		counts:   make(map[*Model]int),
+		retired:  make(map[*Model]struct{}),
+	}
+}
+
+// hold marks the given models as held by a request. It returns an error,
+// holding nothing, if any of them was retired and is not held anymore,
+// since it might have been unloaded already. Every successful call must be
+// followed by a call to release, with the same models.
+func (u *modelUsers) hold(models []*Model) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for _, model := range models {
+		if _, ok := u.retired[model]; ok && u.counts[model] == 0 {
+			return fmt.Errorf("model %#v was removed by a configuration reload", model.Name())
+		}
+	}
+	for _, model := range models {
+		u.counts[model]++
+	}
+	return nil
+}
+
+// release marks the given models as no longer held by a request, unloading
+// the retired ones which are not held anymore.
+func (u *modelUsers) release(models []*Model) {
+	var idle []*Model
+	u.mu.Lock()
+	for _, model := range models {
+		u.counts[model]--
+		if u.counts[model] > 0 {
+			continue
+		}
+		delete(u.counts, model)
+		if _, ok := u.retired[model]; ok {
+			idle = append(idle, model)
+		}
+	}
+	u.mu.Unlock()
+	u.unload(idle)
+}
+
+// retire marks the given models as removed by a reload, returning the
+// ones which are not held by any request, to be unloaded right away. The
+// other ones are unloaded by release.
+func (u *modelUsers) retire(models []*Model) []*Model {
+	var idle []*Model
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for _, model := range models {
+		u.retired[model] = struct{}{}
+		if u.counts[model] == 0 {
+			idle = append(idle, model)
+		}
+	}
+	return idle
+}
+
+// isRetired reports whether the model was removed by a reload.
+func (u *modelUsers) isRetired(model *Model) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	_, ok := u.retired[model]
+	return ok
+}
+
+// unload unloads the loaded models among the given ones, logging any
+// error.
+func (u *modelUsers) unload(models []*Model) {
+	for _, model := range models {
+		if model.Status() != StatusLoaded {
+			continue
+		}
+		if err := u.unloader(model); err != nil {
+			u.logger.Err(err).Str("model", model.Name()).Msg("error unloading model")
+		}
+	}
+}
+
+// SelectRoute is like Route followed by SelectModels, but it also holds
+// the selected models until the returned function is called, so that they
+// are not unloaded in the meantime if a reload removes them (see Reload).
+// Requests translating along the same route more than once (e.g. segment
+// by segment) must use it.
+func (mng *Manager) SelectRoute(source, target, pin string) ([]LanguagePair, func(), error) {
+	// The models are held before a reload can swap the configuration.
+	mng.mu.RLock()
+	defer mng.mu.RUnlock()
+
+	route, err := mng.route(source, target)
+	if err != nil {
+		return nil, nil, err
+	}
+	route, err = SelectModels(route, pin)
+	if err != nil {
+		return nil, nil, err
+	}
+	release, err := mng.holdRoute(route)
+	if err != nil {
+		return nil, nil, err
+	}
+	return route, release, nil
+}
+
+// holdRoute holds the models along the route (see modelUsers.hold),
+// returning a function which releases them.
+func (mng *Manager) holdRoute(route []LanguagePair) (func(), error) {
+	models := make([]*Model, len(route))
+	for i, pair := range route {
+		models[i] = pair.Model
+	}
+	if err := mng.users.hold(models); err != nil {
+		return nil, err
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() { mng.users.release(models) })
+	}, nil
+}
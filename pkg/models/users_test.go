@@ -0,0 +1,64 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagerReloadHeldModels(t *testing.T) {
+	t.Parallel()
+
+	mng := newRouteTestManager(configuration.PivotTranslation{},
+		[2]string{"en", "it"}, [2]string{"it", "en"})
+	mng.users.unloader = func(m *Model) error {
+		m.setStatus(StatusNotLoaded)
+		return nil
+	}
+	enIt, ok := mng.GetModel("en", "it")
+	require.True(t, ok)
+	itEn, ok := mng.GetModel("it", "en")
+	require.True(t, ok)
+	enIt.setStatus(StatusLoaded)
+	itEn.setStatus(StatusLoaded)
+
+	route, release, err := mng.SelectRoute("en", "it", "")
+	require.NoError(t, err)
+	require.Len(t, route, 1)
+	assert.Same(t, enIt, route[0].Model)
+
+	// Both models are removed, but only en-it is held.
+	require.NoError(t, mng.Reload(&configuration.Config{}))
+
+	assert.Equal(t, StatusLoaded, enIt.Status())
+	assert.Equal(t, StatusNotLoaded, itEn.Status())
+
+	// The route selected before the reload can still be translated.
+	acquired, err := mng.acquireRoute(route)
+	require.NoError(t, err)
+	acquired()
+	assert.Equal(t, StatusLoaded, enIt.Status())
+
+	release()
+	assert.Equal(t, StatusNotLoaded, enIt.Status())
+	release() // releasing twice has no effect
+
+	_, err = mng.acquireRoute(route)
+	assert.EqualError(t, err, `model "en-it" was removed by a configuration reload`)
+}
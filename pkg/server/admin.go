@@ -0,0 +1,153 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"strings"
+	"time"
+)
+
+// adminServer is the implementation of api.AdminServer.
+//
+// Every call must be authenticated with the configured admin token.
+type adminServer struct {
+	api.UnimplementedAdminServer
+	*Server
+}
+
+// adminEnabled reports whether the admin service must be served.
+func (s *Server) adminEnabled() bool {
+	return s.config.Admin.Token != ""
+}
+
+// authorize checks the bearer token of an admin call, from the
+// "authorization" metadata (forwarded from the HTTP header of the same
+// name by the gateway). The authentication scheme is case-insensitive.
+func (a *adminServer) authorize(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		parts := strings.SplitN(value, " ", 2)
+		if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+			continue
+		}
+		token := strings.TrimSpace(parts[1])
+		// An empty token would match a disabled admin service.
+		if a.adminEnabled() && subtle.ConstantTimeCompare([]byte(token), []byte(a.config.Admin.Token)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "missing or invalid admin token")
+}
+
+// LoadModel loads a model of a language pair (the first one, unless a model
+// name is given), if it is not loaded yet.
+// It returns once the model is loaded, which may include its download and
+// conversion: meanwhile, the progress can be followed with GetModelStatus.
+func (a *adminServer) LoadModel(ctx context.Context, req *api.LoadModelRequest) (*api.ModelStatusResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+	startTime := time.Now()
+	in := req.GetModelInput()
+	pair, err := a.manager.LoadModel(in.GetSourceLanguage(), in.GetTargetLanguage(), in.GetModel())
+	if err != nil {
+		return &api.ModelStatusResponse{Errors: a.makeErrors(req, err)}, nil
+	}
+	a.logger.Info().Str("model", pair.Model.Name()).Msg("model loaded by admin request")
+	return makeModelStatusResponse(pair, startTime), nil
+}
+
+// UnloadModel unloads a model of a language pair (see
+// models.Manager.UnloadModel).
+func (a *adminServer) UnloadModel(ctx context.Context, req *api.UnloadModelRequest) (*api.ModelStatusResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+	startTime := time.Now()
+	in := req.GetModelInput()
+	pair, err := a.manager.UnloadModel(in.GetSourceLanguage(), in.GetTargetLanguage(), in.GetModel())
+	if err != nil {
+		return &api.ModelStatusResponse{Errors: a.makeErrors(req, err)}, nil
+	}
+	a.logger.Info().Str("model", pair.Model.Name()).Msg("model unloaded by admin request")
+	return makeModelStatusResponse(pair, startTime), nil
+}
+
+// GetModelStatus returns the status of a model of a language pair,
+// including the progress of its loading, while it is being loaded.
+func (a *adminServer) GetModelStatus(ctx context.Context, req *api.GetModelStatusRequest) (*api.ModelStatusResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+	startTime := time.Now()
+	pair, err := a.manager.LookupModel(req.GetSourceLanguage(), req.GetTargetLanguage(), req.GetModel())
+	if err != nil {
+		return &api.ModelStatusResponse{Errors: a.makeErrors(req, err)}, nil
+	}
+	return makeModelStatusResponse(pair, startTime), nil
+}
+
+// ListResidentModels lists the models currently loaded.
+func (a *adminServer) ListResidentModels(ctx context.Context, req *emptypb.Empty) (*api.ListResidentModelsResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+	resident := a.manager.ResidentModels()
+
+	residentModels := make([]*api.ResidentModel, len(resident))
+	for i, r := range resident {
+		residentModels[i] = &api.ResidentModel{
+			SourceLanguage: r.Source,
+			TargetLanguage: r.Target,
+			Model:          makeModelInfo(r.Model),
+			InUse:          int32(r.InUse),
+		}
+		if !r.LastUsed.IsZero() {
+			residentModels[i].LastUsed = r.LastUsed.UTC().Format(time.RFC3339)
+		}
+	}
+
+	resp := &api.ListResidentModelsResponse{
+		Data: &api.ListResidentModelsData{
+			ResidentModels: residentModels,
+		},
+	}
+	return resp, nil
+}
+
+func makeModelStatusResponse(pair models.LanguagePair, startTime time.Time) *api.ModelStatusResponse {
+	data := &api.ModelStatusData{
+		SourceLanguage: pair.Source,
+		TargetLanguage: pair.Target,
+		Model:          makeModelInfo(pair.Model),
+	}
+	if progress, ok := pair.Model.LoadProgress(); ok {
+		data.LoadProgress = &api.LoadProgress{
+			Stage:           string(progress.Stage),
+			StageElapsed:    float32(time.Since(progress.StageStart).Seconds()),
+			DownloadedBytes: progress.DownloadedBytes,
+		}
+	}
+	data.Took = float32(time.Since(startTime).Seconds())
+	return &api.ModelStatusResponse{Data: data}
+}
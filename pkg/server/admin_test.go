@@ -0,0 +1,65 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdminAuthorize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		// token is the configured admin token.
+		token string
+		// authorization are the values of the "authorization" metadata.
+		authorization []string
+		wantCode      codes.Code
+	}{
+		{name: "missing token", token: "s3cret", wantCode: codes.Unauthenticated},
+		{name: "wrong token", token: "s3cret", authorization: []string{"Bearer secret"}, wantCode: codes.Unauthenticated},
+		{name: "token prefix", token: "s3cret", authorization: []string{"Bearer s3c"}, wantCode: codes.Unauthenticated},
+		{name: "missing scheme", token: "s3cret", authorization: []string{"s3cret"}, wantCode: codes.Unauthenticated},
+		{name: "other scheme", token: "s3cret", authorization: []string{"Basic s3cret"}, wantCode: codes.Unauthenticated},
+		{name: "valid token", token: "s3cret", authorization: []string{"Bearer s3cret"}, wantCode: codes.OK},
+		{name: "lowercase scheme", token: "s3cret", authorization: []string{"bearer s3cret"}, wantCode: codes.OK},
+		{name: "uppercase scheme", token: "s3cret", authorization: []string{"BEARER s3cret"}, wantCode: codes.OK},
+		{name: "any of many values", token: "s3cret", authorization: []string{"Bearer secret", "Bearer s3cret"}, wantCode: codes.OK},
+		{name: "admin disabled", authorization: []string{"Bearer "}, wantCode: codes.Unauthenticated},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			a := &adminServer{Server: &Server{config: &configuration.Config{
+				Admin: configuration.Admin{Token: tc.token},
+			}}}
+
+			md := metadata.MD{"authorization": tc.authorization}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			assert.Equal(t, tc.wantCode, status.Code(a.authorize(ctx)))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+	"strings"
+)
+
+// translateWithCache looks up the translation of the given text in the
+// cache, falling back to translateWithManager and caching its result.
+// The route is the one returned by selectRoute for the input.
+//
+// The cache is not used if it is disabled, or if sampling is enabled, by
+// the request or by the configuration of any model of the route (see
+// models.CacheModels). If the input asks to bypass the cache, the lookup
+// is skipped, but the cache is still updated.
+func (s *Server) translateWithCache(in *api.TranslateTextInput, route []models.LanguagePair, text string) ([]models.Translation, error) {
+	if s.cache == nil {
+		return s.translateWithManager(in, route, text)
+	}
+	routeModels, sampling := models.CacheModels(route, generationParams(in.GetGenerationParameters()))
+	if sampling {
+		return s.translateWithManager(in, route, text)
+	}
+
+	normalized, leading, trailing := models.NormalizeCacheText(text)
+	key := models.CacheKey{
+		Source:           route[0].Source,
+		Target:           route[len(route)-1].Target,
+		Models:           routeModels,
+		NumAlternatives:  int(in.GetNumAlternatives()),
+		GlossaryRevision: s.manager.Glossaries().Revision(),
+		DoNotTranslate:   strings.Join(in.GetDoNotTranslate(), "\x00"),
+		Text:             normalized,
+	}
+
+	var translations []models.Translation
+	found := false
+	if !in.GetBypassCache() {
+		translations, found = s.cache.Get(key)
+	}
+	if !found {
+		var err error
+		translations, err = s.translateWithManager(in, route, normalized)
+		if err != nil {
+			return nil, err
+		}
+		s.cache.Add(key, translations)
+	}
+
+	result := make([]models.Translation, len(translations))
+	for i, t := range translations {
+		result[i] = models.Translation{
+			Text:  leading + t.Text + trailing,
+			Score: t.Score,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/langdetect"
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+// autoLanguage is the source language requesting automatic detection.
+const autoLanguage = "auto"
+
+// errNoLanguageDetected is returned when the language of a text cannot be
+// detected at all.
+var errNoLanguageDetected = fmt.Errorf("unable to detect the language of the text")
+
+// DetectLanguage detects the language of a text.
+func (s *Server) DetectLanguage(_ context.Context, req *api.DetectLanguageRequest) (*api.DetectLanguageResponse, error) {
+	startTime := time.Now()
+
+	results := s.detector.Detect(req.GetDetectLanguageInput().GetText())
+	if len(results) == 0 {
+		return &api.DetectLanguageResponse{Errors: s.makeErrors(req, errNoLanguageDetected)}, nil
+	}
+
+	languages := make([]*api.DetectedLanguage, len(results))
+	for i, r := range results {
+		languages[i] = detectedLanguage(r)
+	}
+
+	elapsedTime := time.Since(startTime)
+	resp := &api.DetectLanguageResponse{
+		Data: &api.DetectLanguageData{
+			Took:      float32(elapsedTime.Seconds()),
+			Languages: languages,
+		},
+	}
+	return resp, nil
+}
+
+// resolveSourceLanguage handles the automatic detection of the source
+// language of a translation input.
+//
+// If the source language of the input is not "auto", the input is returned
+// as it is, and the detected language is nil. Otherwise, the language is
+// detected among the ones which can be translated to the target language,
+// and a copy of the input is returned, with the detected source language.
+func (s *Server) resolveSourceLanguage(in *api.TranslateTextInput) (*api.TranslateTextInput, *api.DetectedLanguage, error) {
+	if models.NormalizeLanguage(in.GetSourceLanguage()) != autoLanguage {
+		return in, nil, nil
+	}
+
+	target := in.GetTargetLanguage()
+	var candidates []string
+	for _, lang := range s.detector.Languages() {
+		if _, err := s.manager.Route(lang, target); err == nil {
+			candidates = append(candidates, lang)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil, fmt.Errorf("automatic language detection is not available for translation to %#v", target)
+	}
+
+	results := s.detector.Detect(in.GetText(), candidates...)
+	if len(results) == 0 {
+		return nil, nil, errNoLanguageDetected
+	}
+
+	resolved := proto.Clone(in).(*api.TranslateTextInput)
+	resolved.SourceLanguage = results[0].Language
+	return resolved, detectedLanguage(results[0]), nil
+}
+
+func detectedLanguage(r langdetect.Result) *api.DetectedLanguage {
+	return &api.DetectedLanguage{
+		Language:   r.Language,
+		Confidence: float32(r.Confidence),
+	}
+}
@@ -14,10 +14,14 @@
 
 package server
 
-import "github.com/SpecializedGeneralist/translator/pkg/api"
+import (
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/metrics"
+)
 
 func (s *Server) makeErrors(req interface{}, err error) *api.ResponseErrors {
 	s.logger.Trace().Err(err).Interface("request", req).Send()
+	metrics.Errors.WithLabelValues(metrics.ErrorKindError).Inc()
 	return &api.ResponseErrors{
 		Value: []*api.ResponseError{
 			{Message: err.Error()},
@@ -27,6 +31,7 @@ func (s *Server) makeErrors(req interface{}, err error) *api.ResponseErrors {
 
 func (s *Server) makeFatalErrors(req interface{}, err error) *api.ResponseErrors {
 	s.logger.Error().Err(err).Interface("request", req).Send()
+	metrics.Errors.WithLabelValues(metrics.ErrorKindFatal).Inc()
 	return &api.ResponseErrors{
 		Value: []*api.ResponseError{
 			{Message: err.Error()},
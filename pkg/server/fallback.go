@@ -0,0 +1,161 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/metrics"
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+	"runtime/debug"
+	"time"
+)
+
+// panicError is the error reported for a recovered panic.
+type panicError struct {
+	value interface{}
+	stack string
+}
+
+func (e *panicError) Error() string {
+	return fmt.Sprintf("panic: %v\n%s", e.value, e.stack)
+}
+
+// errDeadlineExceeded is the error reported for a translation attempt which
+// exceeded the fallback timeout.
+var errDeadlineExceeded = errors.New("translation deadline exceeded")
+
+// translateWithFallback translates the given text along the given route.
+//
+// If a fallback is configured for the language pair (see
+// models.Manager.Fallback) and the translation returns an error, panics or
+// exceeds the timeout, the alternative routes are tried in order, and
+// finally the source text is returned untranslated, if configured. The
+// reason of the first failure is reported with the translation. If all the
+// fallbacks fail, the first error is returned.
+//
+// An attempt exceeding the timeout is abandoned, and the next one starts
+// right away, in the same slot of the processing queue. The computation of
+// the abandoned attempt runs to completion in the background, and it takes
+// over the first slot which becomes free (see jobQueue.Adopt).
+func (s *Server) translateWithFallback(in *api.TranslateTextInput, route []models.LanguagePair, text string) (*translation, error) {
+	fallback, ok := s.manager.Fallback(route)
+	if !ok {
+		return s.translateRoute(in, route, text)
+	}
+
+	t, err := s.attemptTranslation(in, route, text, fallback.Timeout)
+	if err == nil {
+		return t, nil
+	}
+	reason := fallbackReason(err)
+
+	for _, r := range fallback.Routes {
+		s.logger.Warn().Err(err).Strs("models", modelNames(r)).Msg("translation failed: trying fallback")
+		t, fbErr := s.attemptTranslation(in, r, text, fallback.Timeout)
+		if fbErr != nil {
+			s.logger.Warn().Err(fbErr).Strs("models", modelNames(r)).Msg("fallback translation failed")
+			continue
+		}
+		kind := metrics.FallbackKindModel
+		if len(r) > 1 {
+			kind = metrics.FallbackKindPivot
+		}
+		metrics.Fallbacks.WithLabelValues(reason, kind).Inc()
+		t.fallbackReason = reason
+		return t, nil
+	}
+
+	if fallback.Untranslated {
+		s.logger.Warn().Err(err).Msg("translation failed: returning the source text")
+		metrics.Fallbacks.WithLabelValues(reason, metrics.FallbackKindUntranslated).Inc()
+		t = &translation{text: text, fallbackReason: reason}
+		if in.GetNumAlternatives() > 0 {
+			t.alternatives = []*api.TranslationAlternative{{TranslatedText: text}}
+		}
+		return t, nil
+	}
+
+	metrics.Fallbacks.WithLabelValues(reason, metrics.FallbackKindNone).Inc()
+	return nil, err
+}
+
+// attemptTranslation runs translateRoute, recovering any panic as a
+// panicError. If timeout is positive, errDeadlineExceeded is returned once
+// it expires (see runAttempt).
+func (s *Server) attemptTranslation(
+	in *api.TranslateTextInput,
+	route []models.LanguagePair,
+	text string,
+	timeout time.Duration,
+) (*translation, error) {
+	return s.runAttempt(func() (*translation, error) {
+		return s.translateRoute(in, route, text)
+	}, timeout)
+}
+
+// runAttempt calls attempt, recovering any panic as a panicError. If
+// timeout is positive, errDeadlineExceeded is returned once it expires, and
+// the abandoned attempt is adopted by the processing queue.
+func (s *Server) runAttempt(attempt func() (*translation, error), timeout time.Duration) (*translation, error) {
+	recovered := func() (t *translation, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = &panicError{value: r, stack: string(debug.Stack())}
+			}
+		}()
+		return attempt()
+	}
+	if timeout <= 0 {
+		return recovered()
+	}
+
+	type result struct {
+		t   *translation
+		err error
+	}
+	ch := make(chan result, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		t, err := recovered()
+		ch <- result{t: t, err: err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case r := <-ch:
+		return r.t, r.err
+	case <-timer.C:
+		s.procQueue.Adopt(done)
+		return nil, errDeadlineExceeded
+	}
+}
+
+// fallbackReason returns the metrics label value describing the reason of
+// a failed translation.
+func fallbackReason(err error) string {
+	var pe *panicError
+	switch {
+	case errors.As(err, &pe):
+		return metrics.FallbackReasonPanic
+	case errors.Is(err, errDeadlineExceeded):
+		return metrics.FallbackReasonDeadline
+	default:
+		return metrics.FallbackReasonError
+	}
+}
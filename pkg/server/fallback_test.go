@@ -0,0 +1,78 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunAttemptTimeout(t *testing.T) {
+	t.Parallel()
+
+	const timeout = 50 * time.Millisecond
+	s := &Server{procQueue: newJobQueue(1), logger: zerolog.Nop()}
+
+	// hang simulates a model whose computation does not complete until
+	// release is closed.
+	release := make(chan struct{})
+	hang := func() (*translation, error) {
+		<-release
+		return &translation{text: "too late"}, nil
+	}
+	fallback := func() (*translation, error) {
+		return &translation{text: "fallback"}, nil
+	}
+
+	start := time.Now()
+	var t1, t2 *translation
+	var err1, err2 error
+	errs := s.runJob(nil, func() error {
+		t1, err1 = s.runAttempt(hang, timeout)
+		t2, err2 = s.runAttempt(fallback, timeout)
+		return nil
+	})
+	elapsed := time.Since(start)
+
+	require.Nil(t, errs)
+	assert.Nil(t, t1)
+	assert.ErrorIs(t, err1, errDeadlineExceeded)
+	require.NoError(t, err2)
+	assert.Equal(t, "fallback", t2.text)
+	assert.Less(t, int64(elapsed), int64(2*timeout), "the fallback must answer within the timeout")
+
+	// The abandoned computation takes over the only slot, until it
+	// completes.
+	assert.Eventually(t, func() bool { return s.procQueue.Running() == 1 }, time.Second, time.Millisecond)
+	close(release)
+	s.procQueue.Wait()
+	assert.Equal(t, 0, s.procQueue.Running())
+}
+
+func TestRunAttemptPanic(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{procQueue: newJobQueue(1)}
+	for _, timeout := range []time.Duration{0, time.Second} {
+		_, err := s.runAttempt(func() (*translation, error) { panic("boom") }, timeout)
+		var pe *panicError
+		require.ErrorAs(t, err, &pe)
+		assert.Equal(t, "boom", pe.value)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+)
+
+// generationParams converts generation parameters from a request.
+func generationParams(p *api.GenerationParameters) configuration.GenerationParams {
+	return configuration.GenerationParams{
+		NumBeams:          int(p.GetNumBeams()),
+		MaxLength:         int(p.GetMaxLength()),
+		LengthPenalty:     float64(p.GetLengthPenalty()),
+		NoRepeatNGramSize: int(p.GetNoRepeatNgramSize()),
+		Temperature:       float64(p.GetTemperature()),
+		TopK:              int(p.GetTopK()),
+		TopP:              float64(p.GetTopP()),
+	}
+}
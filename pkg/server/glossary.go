@@ -0,0 +1,62 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"time"
+)
+
+// UploadGlossary replaces the glossary of a language pair.
+//
+// Uploaded glossaries are only kept in memory: they replace the ones from
+// the configuration until the server is restarted, or the configuration is
+// reloaded.
+func (a *adminServer) UploadGlossary(ctx context.Context, req *api.UploadGlossaryRequest) (*api.UploadGlossaryResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+	startTime := time.Now()
+	in := req.GetUploadGlossaryInput()
+
+	terms := make([]configuration.GlossaryTerm, len(in.GetTerms()))
+	for i, t := range in.GetTerms() {
+		terms[i] = configuration.GlossaryTerm{
+			Source:        t.GetSource(),
+			Target:        t.GetTarget(),
+			CaseSensitive: t.GetCaseSensitive(),
+		}
+	}
+
+	source, target, err := a.manager.SetGlossary(in.GetSourceLanguage(), in.GetTargetLanguage(), terms)
+	if err != nil {
+		return &api.UploadGlossaryResponse{Errors: a.makeErrors(req, err)}, nil
+	}
+	a.logger.Info().Str("source", source).Str("target", target).
+		Int("terms", len(terms)).Msg("glossary uploaded")
+
+	elapsedTime := time.Since(startTime)
+	resp := &api.UploadGlossaryResponse{
+		Data: &api.UploadGlossaryData{
+			Took:           float32(elapsedTime.Seconds()),
+			SourceLanguage: source,
+			TargetLanguage: target,
+			NumTerms:       int32(len(terms)),
+		},
+	}
+	return resp, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net/http"
+	"time"
+)
+
+// readinessCheckInterval is the interval between two consecutive updates
+// of the gRPC health service status.
+const readinessCheckInterval = time.Second
+
+// checkReadiness reports whether the server is ready to accept requests.
+// If it is not, a non-nil error describes the reason.
+func (s *Server) checkReadiness() error {
+	if s.isShuttingDown() {
+		return fmt.Errorf("server is shutting down")
+	}
+	if !s.manager.ModelsLoaded() {
+		return fmt.Errorf("not all models are loaded")
+	}
+	if max := s.config.MaxPendingJobs; max > 0 {
+		if waiting := s.procQueue.Waiting(); waiting > max {
+			return fmt.Errorf("processing queue is saturated: %d pending jobs", waiting)
+		}
+	}
+	return nil
+}
+
+// watchReadiness periodically updates the serving status of the gRPC
+// health service, according to checkReadiness, until ctx is done.
+// The status is set both for the overall server and for the Api service.
+func (s *Server) watchReadiness(ctx context.Context, healthServer *health.Server) {
+	ticker := time.NewTicker(readinessCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		status := healthpb.HealthCheckResponse_SERVING
+		if err := s.checkReadiness(); err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		healthServer.SetServingStatus("", status)
+		healthServer.SetServingStatus(api.Api_ServiceDesc.ServiceName, status)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// handleLiveness is the HTTP handler for liveness probes: it always
+// succeeds, as long as the server is able to answer.
+func (s *Server) handleLiveness(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = fmt.Fprintln(w, "ok")
+}
+
+// handleReadiness is the HTTP handler for readiness probes.
+func (s *Server) handleReadiness(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if err := s.checkReadiness(); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = fmt.Fprintln(w, err)
+		return
+	}
+	_, _ = fmt.Fprintln(w, "ok")
+}
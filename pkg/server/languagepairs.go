@@ -0,0 +1,66 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ListLanguagePairs lists the supported language pairs, together with
+// information on the models handling them.
+func (s *Server) ListLanguagePairs(context.Context, *emptypb.Empty) (*api.ListLanguagePairsResponse, error) {
+	pairs := s.manager.LanguagePairs()
+
+	languagePairs := make([]*api.LanguagePair, len(pairs))
+	for i, pair := range pairs {
+		modelsInfo := make([]*api.ModelInfo, len(pair.Models))
+		for j, wm := range pair.Models {
+			modelsInfo[j] = makeModelInfo(wm.Model)
+			modelsInfo[j].Weight = int32(wm.Weight)
+		}
+		languagePairs[i] = &api.LanguagePair{
+			SourceLanguage: pair.Source,
+			TargetLanguage: pair.Target,
+			Model:          makeModelInfo(pair.Model),
+			SourceAliases:  pair.SourceAliases,
+			TargetAliases:  pair.TargetAliases,
+			Models:         modelsInfo,
+		}
+	}
+
+	resp := &api.ListLanguagePairsResponse{
+		Data: &api.ListLanguagePairsData{
+			LanguagePairs: languagePairs,
+		},
+	}
+	return resp, nil
+}
+
+func makeModelInfo(model *models.Model) *api.ModelInfo {
+	info := &api.ModelInfo{
+		Name:   model.Name(),
+		Status: string(model.Status()),
+		Path:   model.Path(),
+	}
+	if bartConfig, ok := model.BARTConfig(); ok {
+		info.ModelType = bartConfig.ModelType
+		info.VocabSize = int32(bartConfig.VocabSize)
+		info.MaxPositionEmbeddings = int32(bartConfig.MaxPositionEmbeddings)
+	}
+	return info
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/SpecializedGeneralist/translator/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"time"
+)
+
+// metricsHandler returns the HTTP handler exposing Prometheus metrics.
+func metricsHandler() http.Handler {
+	return promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{})
+}
+
+// pairLabels returns the metrics label values for the given source and
+// target languages.
+//
+// Supported language pairs (directly or through pivot translation) are
+// reported with the normalized codes of the matching models, so that
+// aliases and variants of the same languages are counted together.
+// Unsupported language pairs are all reported with metrics.UnknownLabel, to
+// keep the labels cardinality bounded.
+func (s *Server) pairLabels(source, target string) (string, string) {
+	route, err := s.manager.Route(source, target)
+	if err != nil {
+		return metrics.UnknownLabel, metrics.UnknownLabel
+	}
+	return route[0].Source, route[len(route)-1].Target
+}
+
+// countTranslation increments the translation requests counter.
+func (s *Server) countTranslation(source, target string) {
+	sourceLabel, targetLabel := s.pairLabels(source, target)
+	metrics.Translations.WithLabelValues(sourceLabel, targetLabel).Inc()
+}
+
+// observeTranslationDuration records the duration of a successful
+// translation.
+func (s *Server) observeTranslationDuration(source, target string, d time.Duration) {
+	sourceLabel, targetLabel := s.pairLabels(source, target)
+	metrics.TranslationDuration.WithLabelValues(sourceLabel, targetLabel).Observe(d.Seconds())
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/SpecializedGeneralist/translator/pkg/metrics"
+	"github.com/nlpodyssey/spago/pkg/utils/processingqueue"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// jobQueue is a processingqueue.ProcessingQueue which also keeps track of
+// the jobs waiting for a free slot.
+type jobQueue struct {
+	queue   processingqueue.ProcessingQueue
+	waiting int64
+	pending sync.WaitGroup
+}
+
+func newJobQueue(size int) *jobQueue {
+	return &jobQueue{
+		queue: processingqueue.New(size),
+	}
+}
+
+// Run waits for a free slot, then calls f.
+func (q *jobQueue) Run(f func()) {
+	q.pending.Add(1)
+	defer q.pending.Done()
+
+	atomic.AddInt64(&q.waiting, 1)
+	metrics.QueueWaitingJobs.Inc()
+	startTime := time.Now()
+
+	q.queue.Run(func() {
+		atomic.AddInt64(&q.waiting, -1)
+		metrics.QueueWaitingJobs.Dec()
+		metrics.QueueWaitDuration.Observe(time.Since(startTime).Seconds())
+
+		metrics.QueueRunningJobs.Inc()
+		defer metrics.QueueRunningJobs.Dec()
+		f()
+	})
+}
+
+// Adopt keeps a slot busy until done is closed, on behalf of a job which
+// keeps running in the background after its caller stopped waiting for it
+// (see Server.attemptTranslation), so that the queue still limits the
+// computations actually running.
+//
+// It returns immediately, without waiting for a free slot, since the caller
+// usually holds one itself: the adopted job takes the first slot which
+// becomes free (such as the one released by its caller), unless done is
+// closed first. Wait also waits for the adopted job.
+func (q *jobQueue) Adopt(done <-chan struct{}) {
+	q.pending.Add(1)
+	go func() {
+		defer q.pending.Done()
+		select {
+		case <-done:
+			return
+		default:
+		}
+		q.Run(func() {
+			<-done
+		})
+	}()
+}
+
+// Size returns the maximum amount of concurrently running jobs.
+func (q *jobQueue) Size() int {
+	return q.queue.Size()
+}
+
+// Running returns the amount of jobs currently running.
+func (q *jobQueue) Running() int {
+	return len(q.queue)
+}
+
+// Waiting returns the amount of jobs waiting for a free slot.
+func (q *jobQueue) Waiting() int {
+	return int(atomic.LoadInt64(&q.waiting))
+}
+
+// Wait blocks until there are no more running or waiting jobs.
+func (q *jobQueue) Wait() {
+	q.pending.Wait()
+}
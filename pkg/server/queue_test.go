@@ -0,0 +1,74 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobQueueAdopt(t *testing.T) {
+	t.Parallel()
+
+	q := newJobQueue(1)
+	done := make(chan struct{})
+
+	// The caller of Adopt holds the only slot: Adopt returns right away,
+	// and the adopted job takes the slot once the caller's job returns.
+	q.Run(func() {
+		adopted := make(chan struct{})
+		go func() {
+			q.Adopt(done)
+			close(adopted)
+		}()
+		select {
+		case <-adopted:
+		case <-time.After(time.Second):
+			t.Error("Adopt waited for a free slot")
+		}
+	})
+	assert.Eventually(t, func() bool { return q.Running() == 1 }, time.Second, time.Millisecond)
+
+	ran := make(chan struct{})
+	go q.Run(func() { close(ran) })
+	select {
+	case <-ran:
+		t.Fatal("a job ran while the adopted job kept the only slot busy")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	waited := make(chan struct{})
+	go func() {
+		q.Wait()
+		close(waited)
+	}()
+	close(done)
+	<-ran
+	select {
+	case <-waited:
+	case <-time.After(time.Second):
+		t.Fatal("Wait did not return after the adopted job completed")
+	}
+
+	// A job completed before a slot is free does not take one.
+	q = newJobQueue(1)
+	done = make(chan struct{})
+	close(done)
+	q.Adopt(done)
+	q.Wait()
+	assert.Equal(t, 0, q.Running())
+}
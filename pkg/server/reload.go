@@ -0,0 +1,61 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"time"
+)
+
+// SetConfigurationReloader sets the function used by the admin
+// ReloadConfiguration call to reload the configuration (see
+// models.Manager.Reload). It must be called before Run.
+func (s *Server) SetConfigurationReloader(reload func() error) {
+	s.reload = reload
+}
+
+// ReloadConfiguration reloads the configuration, loading and unloading
+// models as needed, while translations keep being served. It returns once
+// the new configuration is in place.
+//
+// Only the language models, the language aliases, the glossaries, and
+// the settings affecting translations (pivot translation, entity
+// protection and generation limits) are reloaded: any other change
+// requires a restart.
+func (a *adminServer) ReloadConfiguration(ctx context.Context, req *emptypb.Empty) (*api.ReloadConfigurationResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+	if a.reload == nil {
+		err := fmt.Errorf("configuration reload is not available")
+		return &api.ReloadConfigurationResponse{Errors: a.makeErrors(req, err)}, nil
+	}
+
+	startTime := time.Now()
+	if err := a.reload(); err != nil {
+		return &api.ReloadConfigurationResponse{Errors: a.makeErrors(req, err)}, nil
+	}
+
+	elapsedTime := time.Since(startTime)
+	resp := &api.ReloadConfigurationResponse{
+		Data: &api.ReloadConfigurationData{
+			Took: float32(elapsedTime.Seconds()),
+		},
+	}
+	return resp, nil
+}
@@ -17,69 +17,116 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"github.com/SpecializedGeneralist/translator/pkg/api"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"net"
 	"net/http"
 	"strings"
 )
 
 // Run runs the server according to the configuration.
-func (s *Server) Run() error {
-	ctx, cancel := context.WithCancel(context.Background())
+//
+// When ctx is done, the server is gracefully shut down: new requests are
+// refused, and in-progress requests are given up to the configured
+// ShutdownTimeout to complete. Run returns nil once the shutdown is over.
+func (s *Server) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	grpcServer := grpc.NewServer()
 	api.RegisterApiServer(grpcServer, s)
+	if s.adminEnabled() {
+		api.RegisterAdminServer(grpcServer, &adminServer{Server: s})
+	}
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	go s.watchReadiness(ctx, healthServer)
 
 	gwmux := runtime.NewServeMux()
 	err := api.RegisterApiHandlerServer(ctx, gwmux, s)
 	if err != nil {
 		return fmt.Errorf("failed to register service handler: %w", err)
 	}
+	if s.adminEnabled() {
+		err = api.RegisterAdminHandlerServer(ctx, gwmux, &adminServer{Server: s})
+		if err != nil {
+			return fmt.Errorf("failed to register admin service handler: %w", err)
+		}
+	}
 
 	mux := http.NewServeMux()
 	mux.Handle("/", gwmux)
+	mux.Handle("/translate_text_stream", s.translateTextStreamHandler(gwmux))
+	mux.HandleFunc("/healthz", s.handleLiveness)
+	mux.HandleFunc("/readyz", s.handleReadiness)
+	mux.Handle("/metrics", metricsHandler())
 
 	listener, err := net.Listen("tcp", s.address())
 	if err != nil {
 		return fmt.Errorf("TCP listen error: %w", err)
 	}
 
-	handler := handlerFunc(grpcServer, mux)
+	handler := s.handlerFunc(grpcServer, mux)
 
+	var hs *http.Server
+	var serve func() error
 	if s.config.TLSEnabled {
-		return s.serveTLS(listener, handler)
+		hs, serve, err = s.serveTLS(listener, handler)
+	} else {
+		hs, serve, err = s.serveInsecure(listener, handler)
+	}
+	if err != nil {
+		return err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- serve()
+	}()
+
+	select {
+	case err = <-errCh:
+		return err
+	case <-ctx.Done():
 	}
-	return s.serveInsecure(listener, handler)
+
+	healthServer.Shutdown()
+	return s.shutdown(grpcServer, hs)
 }
 
 func (s *Server) address() string {
 	return fmt.Sprintf("%s:%d", s.config.Host, s.config.Port)
 }
 
-func (s *Server) serveInsecure(listener net.Listener, handler http.Handler) error {
+func (s *Server) serveInsecure(listener net.Listener, handler http.Handler) (*http.Server, func() error, error) {
 	h2s := &http2.Server{}
 	h1s := &http.Server{
 		Handler: h2c.NewHandler(handler, h2s),
 	}
 
-	s.logger.Info().Msgf("Serving on %s (insecure)", s.address())
-	err := h1s.Serve(listener)
-	if err != nil {
-		return fmt.Errorf("server error: %w", err)
+	serve := func() error {
+		s.logger.Info().Msgf("Serving on %s (insecure)", s.address())
+		err := h1s.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("server error: %w", err)
+		}
+		return nil
 	}
-	return nil
+	return h1s, serve, nil
 }
 
-func (s *Server) serveTLS(listener net.Listener, handler http.Handler) error {
+func (s *Server) serveTLS(listener net.Listener, handler http.Handler) (*http.Server, func() error, error) {
 	tlsCert, err := tls.LoadX509KeyPair(s.config.TLSCert, s.config.TLSKey)
 	if err != nil {
-		return fmt.Errorf("error loading TLS certificate: %w", err)
+		return nil, nil, fmt.Errorf("error loading TLS certificate: %w", err)
 	}
 
 	hs := &http.Server{
@@ -90,16 +137,31 @@ func (s *Server) serveTLS(listener net.Listener, handler http.Handler) error {
 		},
 	}
 
-	s.logger.Info().Msgf("Serving on %s (TLS)", s.address())
-	err = hs.Serve(tls.NewListener(listener, hs.TLSConfig))
-	if err != nil {
-		return fmt.Errorf("server error: %w", err)
+	serve := func() error {
+		s.logger.Info().Msgf("Serving on %s (TLS)", s.address())
+		err := hs.Serve(tls.NewListener(listener, hs.TLSConfig))
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("server error: %w", err)
+		}
+		return nil
 	}
-	return nil
+	return hs, serve, nil
 }
 
-func handlerFunc(grpcServer *grpc.Server, otherHandler http.Handler) http.Handler {
+// handlerFunc dispatches each request either to the gRPC server or to
+// otherHandler, keeping track of in-flight requests. While the server is
+// shutting down, new requests are refused (except for health checks and
+// metrics, which are never tracked).
+func (s *Server) handlerFunc(grpcServer *grpc.Server, otherHandler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isProbeRequest(r) {
+			if !s.startRequest() {
+				refuseRequest(w, r)
+				return
+			}
+			defer s.inFlight.Done()
+		}
+
 		if isGRPCRequest(r) {
 			grpcServer.ServeHTTP(w, r)
 		} else {
@@ -111,3 +173,10 @@ func handlerFunc(grpcServer *grpc.Server, otherHandler http.Handler) http.Handle
 func isGRPCRequest(r *http.Request) bool {
 	return r.ProtoMajor == 2 && strings.Contains(r.Header.Get("Content-Type"), "application/grpc")
 }
+
+func isProbeRequest(r *http.Request) bool {
+	if isGRPCRequest(r) {
+		return strings.HasPrefix(r.URL.Path, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+	}
+	return r.URL.Path == "/healthz" || r.URL.Path == "/readyz" || r.URL.Path == "/metrics"
+}
@@ -16,14 +16,18 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/SpecializedGeneralist/translator/pkg/api"
 	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/SpecializedGeneralist/translator/pkg/langdetect"
 	"github.com/SpecializedGeneralist/translator/pkg/models"
-	"github.com/nlpodyssey/spago/pkg/utils/processingqueue"
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"runtime"
 	"runtime/debug"
+	"sync"
 	"time"
 )
 
@@ -32,27 +36,153 @@ type Server struct {
 	api.UnimplementedApiServer
 	config    *configuration.Config
 	manager   *models.Manager
+	detector  *langdetect.Detector
 	logger    zerolog.Logger
-	procQueue processingqueue.ProcessingQueue
+	procQueue *jobQueue
+	// cache is the translation cache, or nil if it is disabled.
+	cache *models.Cache
+	// translationJobs keeps track of the asynchronous translation jobs.
+	translationJobs *translationJobs
+	// reload reloads the configuration, if available.
+	reload func() error
+	// mu protects shuttingDown, and the additions to inFlight, so that no
+	// request is added once the drain has started.
+	mu sync.Mutex
+	// inFlight tracks the requests being served (except probes).
+	inFlight sync.WaitGroup
+	// shuttingDown is set once the shutdown is started.
+	shuttingDown bool
 }
 
 // New creates a new Server.
 func New(config *configuration.Config, manager *models.Manager, logger zerolog.Logger) *Server {
-	return &Server{
-		config:    config,
-		manager:   manager,
-		logger:    logger,
-		procQueue: processingqueue.New(config.MaxConcurrentComputations),
+	s := &Server{
+		config:          config,
+		manager:         manager,
+		detector:        langdetect.Default(),
+		logger:          logger,
+		procQueue:       newJobQueue(config.MaxConcurrentComputations),
+		translationJobs: newTranslationJobs(config.TranslationJobs),
 	}
+	if config.Cache.MaxEntries > 0 {
+		s.cache = models.NewCache(config.Cache.MaxEntries, config.Cache.TTL)
+	}
+	return s
 }
 
 // TranslateText translates a text.
-func (s *Server) TranslateText(_ context.Context, req *api.TranslateTextRequest) (resp *api.TranslateTextResponse, _ error) {
+func (s *Server) TranslateText(_ context.Context, req *api.TranslateTextRequest) (*api.TranslateTextResponse, error) {
+	return s.translateText(req, req.GetTranslateTextInput()), nil
+}
+
+// TranslateTexts translates many texts at once.
+//
+// Each input is scheduled independently on the processing queue, so that
+// texts for different language pairs can be freely mixed. The results are
+// returned in the same order as the inputs, each one reporting its own
+// data or errors: a failure on one input does not affect the others.
+//
+// Requests with more inputs than the configured MaxBatchInputs are
+// rejected with an InvalidArgument status.
+func (s *Server) TranslateTexts(_ context.Context, req *api.TranslateTextsRequest) (*api.TranslateTextsResponse, error) {
+	startTime := time.Now()
+
+	inputs := req.GetTranslateTextsInput().GetInputs()
+	if max := s.maxBatchInputs(); len(inputs) > max {
+		return nil, status.Errorf(codes.InvalidArgument, "too many inputs: %d exceeds the limit of %d", len(inputs), max)
+	}
+	results := make([]*api.TranslateTextResponse, len(inputs))
+
+	var wg sync.WaitGroup
+	wg.Add(len(inputs))
+	for i, in := range inputs {
+		go func(i int, in *api.TranslateTextInput) {
+			defer wg.Done()
+			results[i] = s.translateText(req, in)
+		}(i, in)
+	}
+	wg.Wait()
+
+	elapsedTime := time.Since(startTime)
+	resp := &api.TranslateTextsResponse{
+		Data: &api.TranslateTextsData{
+			Took:    float32(elapsedTime.Seconds()),
+			Results: results,
+		},
+	}
+	return resp, nil
+}
+
+// maxBatchInputs returns the maximum amount of inputs of a TranslateTexts
+// request.
+func (s *Server) maxBatchInputs() int {
+	if s.config.MaxBatchInputs > 0 {
+		return s.config.MaxBatchInputs
+	}
+	return configuration.DefaultMaxBatchInputs
+}
+
+// translateText runs the translation of a single input on the processing
+// queue. The original request is only used for logging purposes.
+func (s *Server) translateText(req interface{}, in *api.TranslateTextInput) *api.TranslateTextResponse {
+	var data *api.TranslateTextData
+
+	resolved, detected, err := s.resolveSourceLanguage(in)
+	if err == nil {
+		in = resolved
+	}
+
+	source := in.GetSourceLanguage()
+	target := in.GetTargetLanguage()
+	s.countTranslation(source, target)
+
+	if err != nil {
+		return &api.TranslateTextResponse{Errors: s.makeErrors(req, err)}
+	}
+
+	errs := s.runJob(req, func() error {
+		startTime := time.Now()
+
+		route, release, err := s.selectRoute(in)
+		if err != nil {
+			return err
+		}
+		defer release()
+		t, err := s.translate(in, route, in.GetText())
+		if err != nil {
+			return err
+		}
+
+		elapsedTime := time.Since(startTime)
+		s.observeTranslationDuration(source, target, elapsedTime)
+		data = &api.TranslateTextData{
+			TranslatedText:   t.text,
+			Took:             float32(elapsedTime.Seconds()),
+			Alternatives:     t.alternatives,
+			PivotLanguages:   t.pivotLanguages,
+			DetectedLanguage: detected,
+			Models:           t.models,
+			FallbackReason:   t.fallbackReason,
+		}
+		return nil
+	})
+
+	if errs != nil {
+		return &api.TranslateTextResponse{Errors: errs}
+	}
+	return &api.TranslateTextResponse{Data: data}
+}
+
+// runJob waits for a free slot on the processing queue, then runs f.
+// An error returned by f is converted to ResponseErrors; a panic (also
+// when reported as a panicError by f) is recovered and converted to fatal
+// ResponseErrors.
+func (s *Server) runJob(req interface{}, f func() error) (errs *api.ResponseErrors) {
 	s.procQueue.Run(func() {
 		defer func() {
 			if r := recover(); r != nil {
 				st := string(debug.Stack())
-				resp = &api.TranslateTextResponse{Errors: s.makeFatalErrors(req, fmt.Errorf("panic: %v\n%s", r, st))}
+				errs = s.makeFatalErrors(req, fmt.Errorf("panic: %v\n%s", r, st))
 			}
 		}()
 
@@ -60,28 +190,14 @@ func (s *Server) TranslateText(_ context.Context, req *api.TranslateTextRequest)
 		runtime.GC()
 		defer runtime.GC()
 
-		startTime := time.Now()
-
-		in := req.GetTranslateTextInput()
-		source := in.GetSourceLanguage()
-		target := in.GetTargetLanguage()
-		text := in.GetText()
-
-		translatedText, err := s.manager.Translate(source, target, text)
-
-		if err != nil {
-			resp = &api.TranslateTextResponse{Errors: s.makeErrors(req, err)}
-			return
-		}
-
-		elapsedTime := time.Since(startTime)
-		resp = &api.TranslateTextResponse{
-			Data: &api.TranslateTextData{
-				TranslatedText: translatedText,
-				Took:           float32(elapsedTime.Seconds()),
-			},
+		if err := f(); err != nil {
+			var pe *panicError
+			if errors.As(err, &pe) {
+				errs = s.makeFatalErrors(req, err)
+			} else {
+				errs = s.makeErrors(req, err)
+			}
 		}
 	})
-
-	return resp, nil
+	return errs
 }
@@ -0,0 +1,151 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestServer returns a Server for the given configuration, whose models
+// manager has no language models.
+func newTestServer(t *testing.T, config *configuration.Config) *Server {
+	if config.MaxConcurrentComputations == 0 {
+		config.MaxConcurrentComputations = 2
+	}
+	manager := models.NewManager(config, zerolog.Nop())
+	require.NoError(t, manager.LoadModels())
+	return New(config, manager, zerolog.Nop())
+}
+
+// newTestGateway returns the HTTP gateway of the Api service of a Server.
+func newTestGateway(t *testing.T, s *Server) http.Handler {
+	gwmux := runtime.NewServeMux()
+	require.NoError(t, api.RegisterApiHandlerServer(context.Background(), gwmux, s))
+	return gwmux
+}
+
+func TestTranslateTexts(t *testing.T) {
+	t.Parallel()
+
+	s := newTestServer(t, &configuration.Config{MaxBatchInputs: 2})
+
+	testCases := []struct {
+		name     string
+		inputs   []*api.TranslateTextInput
+		wantCode codes.Code
+		// wantErrors are the expected error messages of the results,
+		// in order.
+		wantErrors []string
+	}{
+		{
+			name:     "no inputs",
+			wantCode: codes.OK,
+		},
+		{
+			name: "each input reports its own errors, in order",
+			inputs: []*api.TranslateTextInput{
+				{SourceLanguage: "en", TargetLanguage: "it", Text: "Hello"},
+				{SourceLanguage: "fr", TargetLanguage: "de", Text: "Bonjour"},
+			},
+			wantCode: codes.OK,
+			wantErrors: []string{
+				`no model available for translation from "en" to "it"`,
+				`no model available for translation from "fr" to "de"`,
+			},
+		},
+		{
+			name: "too many inputs",
+			inputs: []*api.TranslateTextInput{
+				{SourceLanguage: "en", TargetLanguage: "it", Text: "a"},
+				{SourceLanguage: "en", TargetLanguage: "it", Text: "b"},
+				{SourceLanguage: "en", TargetLanguage: "it", Text: "c"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			resp, err := s.TranslateTexts(context.Background(), &api.TranslateTextsRequest{
+				TranslateTextsInput: &api.TranslateTextsInput{Inputs: tc.inputs},
+			})
+			assert.Equal(t, tc.wantCode, status.Code(err))
+			if tc.wantCode != codes.OK {
+				assert.Nil(t, resp)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.Data.Results, len(tc.inputs))
+			for i, result := range resp.Data.Results {
+				assert.Nil(t, result.Data)
+				require.NotNil(t, result.Errors)
+				assert.Equal(t, tc.wantErrors[i], result.Errors.Value[0].Message)
+			}
+		})
+	}
+}
+
+func TestTranslateTextsHTTP(t *testing.T) {
+	t.Parallel()
+
+	gateway := newTestGateway(t, newTestServer(t, &configuration.Config{MaxBatchInputs: 1}))
+
+	testCases := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "within the limit",
+			body:       `{"inputs": [{"source_language": "en", "target_language": "it", "text": "Hello"}]}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "too many inputs",
+			body: `{"inputs": [
+				{"source_language": "en", "target_language": "it", "text": "Hello"},
+				{"source_language": "en", "target_language": "it", "text": "World"}
+			]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/translate_texts", strings.NewReader(tc.body))
+			gateway.ServeHTTP(rec, req)
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+		})
+	}
+}
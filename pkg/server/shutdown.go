@@ -0,0 +1,122 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"net/http"
+	"strconv"
+)
+
+// shutdown gracefully stops the server.
+//
+// New requests are refused immediately; then, in-progress requests,
+// translation jobs and jobs in the processing queue are given up to the
+// configured ShutdownTimeout to complete, before stopping the HTTP and gRPC
+// servers.
+//
+// The gRPC server is only used through ServeHTTP, whose transports do not
+// implement draining: GracefulStop would panic while any gRPC stream (such
+// as a health Watch, which is never tracked as in-flight) is open. So the
+// HTTP server is shut down first, then the gRPC server is stopped, closing
+// any stream left.
+func (s *Server) shutdown(grpcServer *grpc.Server, hs *http.Server) error {
+	s.logger.Info().Msg("shutting down...")
+	s.mu.Lock()
+	s.shuttingDown = true
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.ShutdownTimeout)
+	defer cancel()
+
+	if s.drain(ctx) {
+		s.logger.Info().Msg("all in-progress requests completed")
+	} else {
+		s.logger.Warn().
+			Int("running", s.procQueue.Running()).
+			Int("waiting", s.procQueue.Waiting()).
+			Msg("shutdown timeout expired: aborting in-progress requests")
+	}
+
+	err := hs.Shutdown(ctx)
+	grpcServer.Stop()
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("HTTP server shutdown error: closing all connections")
+		if err = hs.Close(); err != nil {
+			return fmt.Errorf("server close error: %w", err)
+		}
+	}
+
+	s.logger.Info().Msg("server stopped")
+	return nil
+}
+
+// startRequest starts tracking a request in inFlight, unless the server is
+// shutting down. It reports whether the request was started: if so,
+// inFlight.Done must be called once it is served.
+func (s *Server) startRequest() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.shuttingDown {
+		return false
+	}
+	s.inFlight.Add(1)
+	return true
+}
+
+// isShuttingDown reports whether the shutdown is started.
+func (s *Server) isShuttingDown() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.shuttingDown
+}
+
+// drain waits for in-flight requests, translation jobs and processing queue
+// jobs (including the abandoned translation attempts adopted by the queue)
+// to complete.
+// It reports false if ctx is done before then.
+func (s *Server) drain(ctx context.Context) bool {
+	done := make(chan struct{})
+	go func() {
+		s.inFlight.Wait()
+		s.translationJobs.wait()
+		s.procQueue.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// refuseRequest replies to a request received while the server is shutting
+// down, with a gRPC UNAVAILABLE status or an HTTP 503 status code.
+func refuseRequest(w http.ResponseWriter, r *http.Request) {
+	if isGRPCRequest(r) {
+		w.Header().Set("Content-Type", "application/grpc")
+		w.Header().Set("Grpc-Status", strconv.Itoa(int(codes.Unavailable)))
+		w.Header().Set("Grpc-Message", "server is shutting down")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.Header().Set("Connection", "close")
+	http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os/signal"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHandlerFuncShutdown(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{procQueue: newJobQueue(1), translationJobs: newTranslationJobs(configuration.TranslationJobs{})}
+	release := make(chan struct{})
+	started := make(chan struct{})
+	handler := s.handlerFunc(grpc.NewServer(), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			close(started)
+			<-release
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/slow", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}()
+	<-started
+
+	s.mu.Lock()
+	s.shuttingDown = true
+	s.mu.Unlock()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/translate_text", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.False(t, s.drain(ctx), "the drain must wait for the in-flight request")
+
+	close(release)
+	wg.Wait()
+	assert.True(t, s.drain(context.Background()))
+}
+
+func TestReadinessShutdown(t *testing.T) {
+	t.Parallel()
+
+	config := &configuration.Config{}
+	s := &Server{
+		config:    config,
+		manager:   models.NewManager(config, zerolog.Nop()),
+		procQueue: newJobQueue(1),
+	}
+	assert.NoError(t, s.checkReadiness())
+
+	s.mu.Lock()
+	s.shuttingDown = true
+	s.mu.Unlock()
+
+	rec := httptest.NewRecorder()
+	s.handleReadiness(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), "shutting down")
+}
+
+// TestRunShutdownHealthWatch checks that the server shuts down on SIGTERM,
+// as the CLI does, while a client is watching the gRPC health status.
+// It must not run in parallel with other tests, since it sends SIGTERM to
+// the test process.
+func TestRunShutdownHealthWatch(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	s := newTestServer(t, &configuration.Config{
+		Host:            "127.0.0.1",
+		Port:            port,
+		ShutdownTimeout: 5 * time.Second,
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+	defer stop()
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- s.Run(ctx)
+	}()
+
+	dialCtx, cancelDial := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelDial()
+	conn, err := grpc.DialContext(dialCtx, s.address(), grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	watch, err := healthpb.NewHealthClient(conn).Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	resp, err := watch.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGTERM))
+
+	select {
+	case err := <-runErr:
+		assert.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the server did not shut down")
+	}
+
+	for {
+		if _, err := watch.Recv(); err != nil {
+			break
+		}
+	}
+}
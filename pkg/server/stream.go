@@ -0,0 +1,201 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/formats"
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"net/http"
+	"time"
+	"unicode/utf8"
+)
+
+// TranslateTextStream translates a text segment by segment, sending each
+// translated segment as soon as it is available.
+func (s *Server) TranslateTextStream(req *api.TranslateTextRequest, stream api.Api_TranslateTextStreamServer) error {
+	return s.translateTextStream(stream.Context(), req, req.GetTranslateTextInput(), stream.Send)
+}
+
+// translateTextStream splits the input text into segments and translates
+// them one by one, passing each result to send.
+//
+// Each segment is scheduled separately on the processing queue, so that
+// long documents do not monopolize it. The process stops at the first
+// translation error (which is sent as well), or when ctx is done.
+func (s *Server) translateTextStream(
+	ctx context.Context,
+	req interface{},
+	in *api.TranslateTextInput,
+	send func(*api.TranslateTextStreamResponse) error,
+) error {
+	var route []models.LanguagePair
+	var release func()
+	resolved, detected, err := s.resolveSourceLanguage(in)
+	if err == nil {
+		in = resolved
+		err = checkStreamFormat(in)
+	}
+	if err == nil {
+		// The models are chosen once, so that all the segments are
+		// translated by the same ones.
+		route, release, err = s.selectRoute(in)
+	}
+
+	s.countTranslation(in.GetSourceLanguage(), in.GetTargetLanguage())
+
+	if err != nil {
+		return send(&api.TranslateTextStreamResponse{Errors: s.makeErrors(req, err)})
+	}
+	defer release()
+
+	text := in.GetText()
+	segments := models.SplitSegments(text, in.GetSourceLanguage())
+
+	// Segments are sorted, so code point offsets can be computed
+	// incrementally.
+	runeOffset, byteOffset := 0, 0
+	toRuneOffset := func(offset int) int32 {
+		runeOffset += utf8.RuneCountInString(text[byteOffset:offset])
+		byteOffset = offset
+		return int32(runeOffset)
+	}
+
+	for i, segment := range segments {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		resp := s.translateSegment(req, in, route, segment)
+		if resp.Data != nil {
+			resp.Data.DetectedLanguage = detected
+			resp.Data.Index = int32(i)
+			resp.Data.Start = toRuneOffset(segment.Start)
+			resp.Data.End = toRuneOffset(segment.End)
+		}
+
+		if err := send(resp); err != nil {
+			return err
+		}
+		if resp.Errors != nil {
+			return nil
+		}
+	}
+	return nil
+}
+
+// checkStreamFormat returns an error if the format of the input is not
+// supported for streaming, since structured documents cannot be split
+// into segments as plain texts.
+func checkStreamFormat(in *api.TranslateTextInput) error {
+	format, err := formats.ParseFormat(in.GetFormat())
+	if err != nil {
+		return err
+	}
+	if format != formats.Text {
+		return fmt.Errorf("format %#v is not supported for streaming", format)
+	}
+	return nil
+}
+
+func (s *Server) translateSegment(
+	req interface{},
+	in *api.TranslateTextInput,
+	route []models.LanguagePair,
+	segment models.Segment,
+) *api.TranslateTextStreamResponse {
+	var data *api.TranslatedSegment
+
+	errs := s.runJob(req, func() error {
+		startTime := time.Now()
+
+		source := in.GetSourceLanguage()
+		target := in.GetTargetLanguage()
+
+		t, err := s.translate(in, route, segment.Text)
+		if err != nil {
+			return err
+		}
+
+		elapsedTime := time.Since(startTime)
+		s.observeTranslationDuration(source, target, elapsedTime)
+		data = &api.TranslatedSegment{
+			TranslatedText: t.text,
+			Took:           float32(elapsedTime.Seconds()),
+			Alternatives:   t.alternatives,
+			PivotLanguages: t.pivotLanguages,
+			Models:         t.models,
+			FallbackReason: t.fallbackReason,
+		}
+		return nil
+	})
+
+	if errs != nil {
+		return &api.TranslateTextStreamResponse{Errors: errs}
+	}
+	return &api.TranslateTextStreamResponse{Data: data}
+}
+
+// translateTextStreamHandler returns an HTTP handler for TranslateTextStream.
+//
+// The request body is a JSON-encoded api.TranslateTextInput, and the
+// response is a stream of newline-delimited api.TranslateTextStreamResponse
+// JSON objects, flushed one by one. Marshalers and error handling are the
+// same as the ones used by the given grpc-gateway mux.
+func (s *Server) translateTextStreamHandler(gwmux *runtime.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(gwmux, r)
+
+		if r.Method != http.MethodPost {
+			runtime.HTTPError(ctx, gwmux, outboundMarshaler, w, r, status.Error(codes.Unimplemented, http.StatusText(http.StatusMethodNotAllowed)))
+			return
+		}
+
+		in := new(api.TranslateTextInput)
+		if err := inboundMarshaler.NewDecoder(r.Body).Decode(in); err != nil && err != io.EOF {
+			runtime.HTTPError(ctx, gwmux, outboundMarshaler, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		flusher, _ := w.(http.Flusher)
+
+		send := func(resp *api.TranslateTextStreamResponse) error {
+			buf, err := outboundMarshaler.Marshal(resp)
+			if err != nil {
+				return err
+			}
+			if _, err = w.Write(append(buf, '\n')); err != nil {
+				return err
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+			return nil
+		}
+
+		req := &api.TranslateTextRequest{TranslateTextInput: in}
+		if err := s.translateTextStream(ctx, req, in, send); err != nil {
+			s.logger.Debug().Err(err).Msg("translate text stream interrupted")
+		}
+	})
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateTextStreamHandler(t *testing.T) {
+	t.Parallel()
+
+	s := newTestServer(t, &configuration.Config{})
+	handler := s.translateTextStreamHandler(runtime.NewServeMux())
+
+	testCases := []struct {
+		name       string
+		method     string
+		body       string
+		wantStatus int
+		// wantErrors are the expected error messages of the streamed
+		// lines, one per line.
+		wantErrors []string
+	}{
+		{
+			name:       "method not allowed",
+			method:     http.MethodGet,
+			wantStatus: http.StatusNotImplemented,
+		},
+		{
+			name:       "malformed body",
+			method:     http.MethodPost,
+			body:       `{"text": `,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unsupported language pair",
+			method:     http.MethodPost,
+			body:       `{"source_language": "en", "target_language": "it", "text": "Hello. World."}`,
+			wantStatus: http.StatusOK,
+			wantErrors: []string{`no model available for translation from "en" to "it"`},
+		},
+		{
+			name:       "unsupported format",
+			method:     http.MethodPost,
+			body:       `{"source_language": "en", "target_language": "it", "text": "<p>Hello</p>", "format": "html"}`,
+			wantStatus: http.StatusOK,
+			wantErrors: []string{`format "html" is not supported for streaming`},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.method, "/translate_text_stream", strings.NewReader(tc.body))
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+
+			lines := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n"), "\n")
+			require.Len(t, lines, len(tc.wantErrors))
+			for i, line := range lines {
+				var resp struct {
+					Data   json.RawMessage
+					Errors struct {
+						Value []struct{ Message string }
+					}
+				}
+				require.NoError(t, json.Unmarshal([]byte(line), &resp), line)
+				require.Len(t, resp.Errors.Value, 1, line)
+				assert.Equal(t, tc.wantErrors[i], resp.Errors.Value[0].Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/formats"
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+)
+
+// translation is the result of translate.
+type translation struct {
+	// text is the best translation.
+	text string
+	// alternatives are only set if the input requests them.
+	alternatives []*api.TranslationAlternative
+	// pivotLanguages are the intermediate languages of the translation,
+	// if any.
+	pivotLanguages []string
+	// models are the names of the models which served the translation, one
+	// for each step of the route.
+	models []string
+	// fallbackReason is set if the translation was served by a fallback
+	// (see translateWithFallback).
+	fallbackReason string
+}
+
+// selectRoute returns the route for the language pair of the input, with
+// the models chosen for a single request, and the function which releases
+// them once the request is completed (see models.Manager.SelectRoute).
+func (s *Server) selectRoute(in *api.TranslateTextInput) ([]models.LanguagePair, func(), error) {
+	return s.manager.SelectRoute(in.GetSourceLanguage(), in.GetTargetLanguage(), in.GetModel())
+}
+
+// translate translates the given text according to the input parameters,
+// along the given route (see selectRoute), falling back as configured if
+// the translation fails (see translateWithFallback).
+func (s *Server) translate(in *api.TranslateTextInput, route []models.LanguagePair, text string) (*translation, error) {
+	if err := s.checkInput(in); err != nil {
+		return nil, err
+	}
+	return s.translateWithFallback(in, route, text)
+}
+
+// checkInput returns an error if the input parameters are invalid.
+func (s *Server) checkInput(in *api.TranslateTextInput) error {
+	format, err := formats.ParseFormat(in.GetFormat())
+	if err != nil {
+		return err
+	}
+	if format != formats.Text && in.GetNumAlternatives() > 0 {
+		return fmt.Errorf("alternatives are not supported for format %#v", format)
+	}
+	n := int(in.GetNumAlternatives())
+	if n == 0 {
+		n = 1
+	}
+	return s.manager.CheckParams(generationParams(in.GetGenerationParameters()), n)
+}
+
+// translateRoute translates the given text along the given route, with
+// input parameters already checked by checkInput.
+func (s *Server) translateRoute(in *api.TranslateTextInput, route []models.LanguagePair, text string) (*translation, error) {
+	format, err := formats.ParseFormat(in.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	t := &translation{
+		pivotLanguages: models.IntermediateLanguages(route),
+		models:         modelNames(route),
+	}
+
+	if format != formats.Text {
+		t.text, err = formats.Translate(format, text, func(chunk string) (string, error) {
+			translations, err := s.translateWithCache(in, route, chunk)
+			if err != nil {
+				return "", err
+			}
+			return translations[0].Text, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+
+	translations, err := s.translateWithCache(in, route, text)
+	if err != nil {
+		return nil, err
+	}
+	t.text = translations[0].Text
+	if in.GetNumAlternatives() > 0 {
+		t.alternatives = alternatives(translations)
+	}
+	return t, nil
+}
+
+// translateWithManager translates the given text along the route with the
+// models manager, returning the alternative translations only if the input
+// requests them.
+func (s *Server) translateWithManager(in *api.TranslateTextInput, route []models.LanguagePair, text string) ([]models.Translation, error) {
+	params := generationParams(in.GetGenerationParameters())
+	opts := models.TranslateOptions{
+		DoNotTranslate: in.GetDoNotTranslate(),
+	}
+
+	n := int(in.GetNumAlternatives())
+	if n == 0 {
+		translations, err := s.manager.TranslateRoute(route, text, params, 1, opts)
+		if err != nil {
+			return nil, err
+		}
+		return translations[:1], nil
+	}
+	return s.manager.TranslateRoute(route, text, params, n, opts)
+}
+
+// modelNames returns the names of the models of a route.
+func modelNames(route []models.LanguagePair) []string {
+	names := make([]string, len(route))
+	for i, pair := range route {
+		names[i] = pair.Model.Name()
+	}
+	return names
+}
+
+// alternatives converts translations to API alternatives.
+func alternatives(translations []models.Translation) []*api.TranslationAlternative {
+	alts := make([]*api.TranslationAlternative, len(translations))
+	for i, t := range translations {
+		alts[i] = &api.TranslationAlternative{
+			TranslatedText: t.Text,
+			Score:          float32(t.Score),
+		}
+	}
+	return alts
+}
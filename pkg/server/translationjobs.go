@@ -0,0 +1,330 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/SpecializedGeneralist/translator/pkg/formats"
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Statuses of a translationJob.
+const (
+	jobStatusQueued    = "queued"
+	jobStatusRunning   = "running"
+	jobStatusCompleted = "completed"
+	jobStatusFailed    = "failed"
+)
+
+// SubmitTranslationJob starts the asynchronous translation of a text,
+// returning the new job immediately.
+//
+// The text is translated in the background, segment by segment, on the
+// processing queue. The job can be polled with GetTranslationJob; when it
+// is completed or failed, the configured webhook (if any) is notified.
+func (s *Server) SubmitTranslationJob(_ context.Context, req *api.SubmitTranslationJobRequest) (*api.SubmitTranslationJobResponse, error) {
+	job, err := s.translationJobs.add()
+	if err != nil {
+		return &api.SubmitTranslationJobResponse{Errors: s.makeErrors(req, err)}, nil
+	}
+
+	s.translationJobs.running.Add(1)
+	go func() {
+		defer s.translationJobs.running.Done()
+		s.runTranslationJob(req, req.GetTranslateTextInput(), job)
+		s.notifyWebhook(job)
+	}()
+
+	return &api.SubmitTranslationJobResponse{Data: job.toAPI()}, nil
+}
+
+// GetTranslationJob returns the status, the progress and, once finished,
+// the result of a translation job.
+func (s *Server) GetTranslationJob(_ context.Context, req *api.GetTranslationJobRequest) (*api.GetTranslationJobResponse, error) {
+	job, ok := s.translationJobs.get(req.GetJobId())
+	if !ok {
+		err := fmt.Errorf("translation job %#v not found", req.GetJobId())
+		return &api.GetTranslationJobResponse{Errors: s.makeErrors(req, err)}, nil
+	}
+	return &api.GetTranslationJobResponse{Data: job.toAPI()}, nil
+}
+
+// runTranslationJob translates the input of a job, keeping track of its
+// progress. The original request is only used for logging purposes.
+//
+// Plain texts are split into segments (see models.SplitSegments), which are
+// scheduled one by one on the processing queue, as for streaming. Other
+// formats, and inputs requesting alternatives, are translated in a single
+// step.
+func (s *Server) runTranslationJob(req interface{}, in *api.TranslateTextInput, job *translationJob) {
+	resolved, detected, err := s.resolveSourceLanguage(in)
+	if err == nil {
+		in = resolved
+	}
+
+	source := in.GetSourceLanguage()
+	target := in.GetTargetLanguage()
+	s.countTranslation(source, target)
+
+	var route []models.LanguagePair
+	var release func()
+	if err == nil {
+		// The models are chosen once, so that all the segments are
+		// translated by the same ones.
+		route, release, err = s.selectRoute(in)
+	}
+	if err != nil {
+		job.finish(nil, s.makeErrors(req, err))
+		return
+	}
+	defer release()
+
+	startTime := time.Now()
+	text := in.GetText()
+	segments := translationJobSegments(in)
+	job.start(len(segments))
+
+	var sb strings.Builder
+	var t *translation
+	fallbackReason := ""
+	lastEnd := 0
+	for _, segment := range segments {
+		errs := s.runJob(req, func() (err error) {
+			t, err = s.translate(in, route, segment.Text)
+			return err
+		})
+		if errs != nil {
+			job.finish(nil, errs)
+			return
+		}
+		sb.WriteString(text[lastEnd:segment.Start])
+		sb.WriteString(t.text)
+		if fallbackReason == "" {
+			fallbackReason = t.fallbackReason
+		}
+		lastEnd = segment.End
+		job.advance()
+	}
+	sb.WriteString(text[lastEnd:])
+
+	elapsedTime := time.Since(startTime)
+	s.observeTranslationDuration(source, target, elapsedTime)
+	job.finish(&api.TranslateTextData{
+		TranslatedText:   sb.String(),
+		Took:             float32(elapsedTime.Seconds()),
+		Alternatives:     t.alternatives,
+		PivotLanguages:   t.pivotLanguages,
+		DetectedLanguage: detected,
+		Models:           t.models,
+		FallbackReason:   fallbackReason,
+	}, nil)
+}
+
+// translationJobSegments returns the segments of the input text which are
+// translated one by one by a job.
+func translationJobSegments(in *api.TranslateTextInput) []models.Segment {
+	text := in.GetText()
+	format, err := formats.ParseFormat(in.GetFormat())
+	if err == nil && format == formats.Text && in.GetNumAlternatives() == 0 {
+		if segments := models.SplitSegments(text, in.GetSourceLanguage()); len(segments) > 0 {
+			return segments
+		}
+	}
+	// The whole text is translated at once, so that any error is reported
+	// as for synchronous translations.
+	return []models.Segment{{Text: text, Start: 0, End: len(text)}}
+}
+
+// translationJob is an asynchronous translation job. It is safe for
+// concurrent use.
+type translationJob struct {
+	id string
+	// mu protects all the following fields.
+	mu                sync.Mutex
+	status            string
+	completedSegments int
+	totalSegments     int
+	result            *api.TranslateTextData
+	errors            *api.ResponseErrors
+	finishedAt        time.Time
+}
+
+// start marks the job as running, with the given total amount of segments
+// to translate.
+func (j *translationJob) start(totalSegments int) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.status = jobStatusRunning
+	j.totalSegments = totalSegments
+}
+
+// advance increments the amount of translated segments.
+func (j *translationJob) advance() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.completedSegments++
+}
+
+// finish marks the job as completed with the given result, or as failed
+// if errs is not nil.
+func (j *translationJob) finish(result *api.TranslateTextData, errs *api.ResponseErrors) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if errs != nil {
+		j.status = jobStatusFailed
+		j.errors = errs
+	} else {
+		j.status = jobStatusCompleted
+		j.result = result
+	}
+	j.finishedAt = time.Now()
+}
+
+// isFinished reports whether the job is completed or failed, and when.
+func (j *translationJob) isFinished() (bool, time.Time) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return !j.finishedAt.IsZero(), j.finishedAt
+}
+
+// toAPI returns a snapshot of the job as api.TranslationJob.
+func (j *translationJob) toAPI() *api.TranslationJob {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	var progress float32
+	switch {
+	case j.status == jobStatusCompleted:
+		progress = 1
+	case j.totalSegments > 0:
+		progress = float32(j.completedSegments) / float32(j.totalSegments)
+	}
+
+	return &api.TranslationJob{
+		JobId:             j.id,
+		Status:            j.status,
+		Progress:          progress,
+		CompletedSegments: int32(j.completedSegments),
+		TotalSegments:     int32(j.totalSegments),
+		Result:            j.result,
+		Errors:            j.errors,
+	}
+}
+
+// translationJobs keeps track of the translation jobs. It is safe for
+// concurrent use.
+type translationJobs struct {
+	maxJobs   int
+	retention time.Duration
+	// running tracks the jobs being processed.
+	running sync.WaitGroup
+	// mu protects jobs and order.
+	mu   sync.Mutex
+	jobs map[string]*translationJob
+	// order lists the jobs by creation time.
+	order []*translationJob
+}
+
+func newTranslationJobs(config configuration.TranslationJobs) *translationJobs {
+	return &translationJobs{
+		maxJobs:   config.MaxJobs,
+		retention: config.Retention,
+		jobs:      make(map[string]*translationJob),
+	}
+}
+
+// add creates a new queued job. If the maximum amount of jobs is reached,
+// the oldest finished job is discarded; if there is none, an error is
+// returned.
+func (js *translationJobs) add() (*translationJob, error) {
+	id, err := newTranslationJobID()
+	if err != nil {
+		return nil, err
+	}
+
+	js.mu.Lock()
+	defer js.mu.Unlock()
+
+	js.purgeExpired()
+	if js.maxJobs > 0 && len(js.order) >= js.maxJobs && !js.evictOldestFinished() {
+		return nil, fmt.Errorf("too many translation jobs in progress: the limit is %d", js.maxJobs)
+	}
+
+	job := &translationJob{id: id, status: jobStatusQueued}
+	js.jobs[id] = job
+	js.order = append(js.order, job)
+	return job, nil
+}
+
+// get returns the job with the given ID, reporting whether it was found.
+func (js *translationJobs) get(id string) (*translationJob, bool) {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	js.purgeExpired()
+	job, ok := js.jobs[id]
+	return job, ok
+}
+
+// purgeExpired discards the finished jobs older than the retention time.
+// It must be called with mu locked.
+func (js *translationJobs) purgeExpired() {
+	if js.retention <= 0 {
+		return
+	}
+	deadline := time.Now().Add(-js.retention)
+	kept := js.order[:0]
+	for _, job := range js.order {
+		if finished, at := job.isFinished(); finished && at.Before(deadline) {
+			delete(js.jobs, job.id)
+			continue
+		}
+		kept = append(kept, job)
+	}
+	js.order = kept
+}
+
+// evictOldestFinished discards the oldest finished job, reporting whether
+// there was any. It must be called with mu locked.
+func (js *translationJobs) evictOldestFinished() bool {
+	for i, job := range js.order {
+		if finished, _ := job.isFinished(); finished {
+			delete(js.jobs, job.id)
+			js.order = append(js.order[:i], js.order[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// wait blocks until there are no more jobs being processed.
+func (js *translationJobs) wait() {
+	js.running.Wait()
+}
+
+func newTranslationJobID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating translation job ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,224 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testJob describes a job already present in translationJobs.
+type testJob struct {
+	status string
+	// finishedAgo is how long ago the job was finished (if it is).
+	finishedAgo time.Duration
+}
+
+func TestTranslationJobsAdd(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		config configuration.TranslationJobs
+		jobs   []testJob
+		// kept are the indices of the existing jobs kept after add.
+		kept    []int
+		wantErr bool
+	}{
+		{
+			name: "no limit keeps all the jobs",
+			jobs: []testJob{{status: jobStatusQueued}, {status: jobStatusRunning}, {status: jobStatusCompleted}},
+			kept: []int{0, 1, 2},
+		},
+		{
+			name:   "the oldest finished job is evicted when the limit is reached",
+			config: configuration.TranslationJobs{MaxJobs: 3},
+			jobs:   []testJob{{status: jobStatusRunning}, {status: jobStatusFailed}, {status: jobStatusCompleted}},
+			kept:   []int{0, 2},
+		},
+		{
+			name:    "unfinished jobs are never evicted",
+			config:  configuration.TranslationJobs{MaxJobs: 2},
+			jobs:    []testJob{{status: jobStatusQueued}, {status: jobStatusRunning}},
+			kept:    []int{0, 1},
+			wantErr: true,
+		},
+		{
+			name:   "finished jobs older than the retention are purged",
+			config: configuration.TranslationJobs{Retention: time.Hour},
+			jobs: []testJob{
+				{status: jobStatusCompleted, finishedAgo: 2 * time.Hour},
+				{status: jobStatusCompleted},
+				{status: jobStatusRunning},
+				{status: jobStatusFailed, finishedAgo: 3 * time.Hour},
+			},
+			kept: []int{1, 2},
+		},
+		{
+			name:   "purged jobs make room before evicting",
+			config: configuration.TranslationJobs{MaxJobs: 2, Retention: time.Hour},
+			jobs:   []testJob{{status: jobStatusCompleted}, {status: jobStatusCompleted, finishedAgo: 2 * time.Hour}},
+			kept:   []int{0},
+		},
+		{
+			name:   "zero retention keeps finished jobs",
+			config: configuration.TranslationJobs{},
+			jobs:   []testJob{{status: jobStatusCompleted, finishedAgo: 1000 * time.Hour}},
+			kept:   []int{0},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			js := newTranslationJobs(tc.config)
+			existing := make([]*translationJob, len(tc.jobs))
+			for i, j := range tc.jobs {
+				job, err := js.add()
+				require.NoError(t, err)
+				setTestJobStatus(job, j)
+				existing[i] = job
+			}
+
+			job, err := js.add()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, jobStatusQueued, job.toAPI().Status)
+			}
+
+			var want []*translationJob
+			for _, i := range tc.kept {
+				want = append(want, existing[i])
+			}
+			if job != nil {
+				want = append(want, job)
+			}
+			assert.Equal(t, want, js.order)
+			assert.Len(t, js.jobs, len(want))
+		})
+	}
+}
+
+// setTestJobStatus brings a queued job to the given status.
+func setTestJobStatus(job *translationJob, j testJob) {
+	switch j.status {
+	case jobStatusRunning:
+		job.start(1)
+	case jobStatusCompleted:
+		job.start(1)
+		job.finish(&api.TranslateTextData{}, nil)
+	case jobStatusFailed:
+		job.start(1)
+		job.finish(nil, &api.ResponseErrors{})
+	}
+	job.mu.Lock()
+	job.finishedAt = job.finishedAt.Add(-j.finishedAgo)
+	job.mu.Unlock()
+}
+
+func TestTranslationJobStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		update       func(job *translationJob)
+		wantStatus   string
+		wantProgress float32
+		wantFinished bool
+	}{
+		{
+			name:       "queued",
+			update:     func(*translationJob) {},
+			wantStatus: jobStatusQueued,
+		},
+		{
+			name: "running",
+			update: func(job *translationJob) {
+				job.start(4)
+				job.advance()
+			},
+			wantStatus:   jobStatusRunning,
+			wantProgress: 0.25,
+		},
+		{
+			name: "completed",
+			update: func(job *translationJob) {
+				job.start(2)
+				job.advance()
+				job.finish(&api.TranslateTextData{TranslatedText: "ciao"}, nil)
+			},
+			wantStatus:   jobStatusCompleted,
+			wantProgress: 1,
+			wantFinished: true,
+		},
+		{
+			name: "failed",
+			update: func(job *translationJob) {
+				job.start(2)
+				job.advance()
+				job.finish(nil, &api.ResponseErrors{Value: []*api.ResponseError{{Message: "boom"}}})
+			},
+			wantStatus:   jobStatusFailed,
+			wantProgress: 0.5,
+			wantFinished: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			job := &translationJob{id: "j", status: jobStatusQueued}
+			tc.update(job)
+
+			snapshot := job.toAPI()
+			assert.Equal(t, tc.wantStatus, snapshot.Status)
+			assert.Equal(t, tc.wantProgress, snapshot.Progress)
+			finished, _ := job.isFinished()
+			assert.Equal(t, tc.wantFinished, finished)
+			assert.Equal(t, tc.wantStatus == jobStatusCompleted, snapshot.Result != nil)
+			assert.Equal(t, tc.wantStatus == jobStatusFailed, snapshot.Errors != nil)
+		})
+	}
+}
+
+func TestGetTranslationJob(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{logger: zerolog.Nop(), translationJobs: newTranslationJobs(configuration.TranslationJobs{})}
+	job, err := s.translationJobs.add()
+	require.NoError(t, err)
+
+	resp, err := s.GetTranslationJob(context.Background(), &api.GetTranslationJobRequest{JobId: job.id})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Errors)
+	assert.Equal(t, job.id, resp.Data.JobId)
+
+	resp, err = s.GetTranslationJob(context.Background(), &api.GetTranslationJobRequest{JobId: "unknown"})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Data)
+	require.NotNil(t, resp.Errors)
+	assert.Contains(t, resp.Errors.Value[0].Message, "not found")
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// defaultWebhookTimeout is the timeout of webhook requests, if not
+	// configured.
+	defaultWebhookTimeout = 10 * time.Second
+	// webhookMaxAttempts is the maximum amount of delivery attempts of a
+	// webhook notification.
+	webhookMaxAttempts = 3
+)
+
+// webhookRetryDelay is the delay before the second delivery attempt of a
+// webhook notification; each further attempt waits one more delay.
+var webhookRetryDelay = time.Second
+
+// webhookMarshaler encodes webhook notifications in the same way as the
+// HTTP API responses.
+var webhookMarshaler = &runtime.JSONPb{
+	MarshalOptions: protojson.MarshalOptions{EmitUnpopulated: true},
+}
+
+// notifyWebhook sends the final state of a translation job to the
+// configured webhook URL, if any, as the JSON-encoded body of a POST
+// request (in the same form as the GetTranslationJob HTTP response).
+//
+// Failed deliveries are retried a few times, with an increasing delay.
+func (s *Server) notifyWebhook(job *translationJob) {
+	url := s.config.TranslationJobs.WebhookURL
+	if url == "" {
+		return
+	}
+	logger := s.logger.With().Str("job_id", job.id).Str("webhook_url", url).Logger()
+
+	body, err := webhookMarshaler.Marshal(&api.GetTranslationJobResponse{Data: job.toAPI()})
+	if err != nil {
+		logger.Err(err).Msg("error encoding webhook notification")
+		return
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = s.postWebhook(url, body)
+		if err == nil {
+			logger.Debug().Msg("webhook notified")
+			return
+		}
+		if attempt == webhookMaxAttempts {
+			logger.Error().Err(err).Msg("webhook notification failed")
+			return
+		}
+		logger.Warn().Err(err).Int("attempt", attempt).Msg("webhook notification failed: retrying")
+		time.Sleep(time.Duration(attempt) * webhookRetryDelay)
+	}
+}
+
+func (s *Server) postWebhook(url string, body []byte) error {
+	timeout := s.config.TranslationJobs.WebhookTimeout
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	client := &http.Client{Timeout: timeout}
+
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected webhook response status %#v", resp.Status)
+	}
+	return nil
+}
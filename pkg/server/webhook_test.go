@@ -0,0 +1,110 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SpecializedGeneralist/translator/pkg/api"
+	"github.com/SpecializedGeneralist/translator/pkg/configuration"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The test is not parallel, since it changes webhookRetryDelay.
+func TestNotifyWebhook(t *testing.T) {
+	const delay = 20 * time.Millisecond
+	defer func(d time.Duration) { webhookRetryDelay = d }(webhookRetryDelay)
+	webhookRetryDelay = delay
+
+	testCases := []struct {
+		name string
+		// failures is the amount of requests answered with an error status
+		// before succeeding.
+		failures     int
+		wantRequests int
+	}{
+		{name: "delivered at the first attempt", failures: 0, wantRequests: 1},
+		{name: "retried after failures", failures: 2, wantRequests: 3},
+		{name: "given up after the maximum attempts", failures: 10, wantRequests: webhookMaxAttempts},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var times []time.Time
+			var bodies [][]byte
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				mu.Lock()
+				defer mu.Unlock()
+				times = append(times, time.Now())
+				bodies = append(bodies, body)
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				if len(times) <= tc.failures {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}))
+			defer ts.Close()
+
+			s := &Server{
+				config: &configuration.Config{
+					TranslationJobs: configuration.TranslationJobs{WebhookURL: ts.URL},
+				},
+				logger: zerolog.Nop(),
+			}
+			job := &translationJob{id: "job-1", status: jobStatusQueued}
+			job.start(1)
+			job.finish(&api.TranslateTextData{TranslatedText: "ciao"}, nil)
+
+			s.notifyWebhook(job)
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Len(t, times, tc.wantRequests)
+			for i := 1; i < len(times); i++ {
+				// The delay increases with each attempt.
+				assert.GreaterOrEqual(t, int64(times[i].Sub(times[i-1])), int64(time.Duration(i)*delay))
+			}
+
+			var notification struct {
+				Data struct {
+					JobID  string `json:"jobId"`
+					Status string `json:"status"`
+					Result struct {
+						TranslatedText string `json:"translatedText"`
+					} `json:"result"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(bodies[0], &notification))
+			assert.Equal(t, "job-1", notification.Data.JobID)
+			assert.Equal(t, jobStatusCompleted, notification.Data.Status)
+			assert.Equal(t, "ciao", notification.Data.Result.TranslatedText)
+		})
+	}
+
+	t.Run("no webhook configured", func(t *testing.T) {
+		s := &Server{config: &configuration.Config{}, logger: zerolog.Nop()}
+		s.notifyWebhook(&translationJob{id: "job-2", status: jobStatusQueued})
+	})
+}
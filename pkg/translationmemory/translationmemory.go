@@ -0,0 +1,245 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package translationmemory provides a persistent translation memory,
+// backed by a Badger embedded key-value store.
+package translationmemory
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/rs/zerolog"
+	"io"
+	"strings"
+)
+
+// Memory is a persistent translation memory.
+// It implements models.TranslationMemory.
+type Memory struct {
+	db *badger.DB
+}
+
+var _ models.TranslationMemory = &Memory{}
+
+// Open opens (or creates) the translation memory stored in the given
+// directory.
+func Open(path string, logger zerolog.Logger) (*Memory, error) {
+	opts := badger.DefaultOptions(path).
+		WithLogger(badgerLogger{logger.With().Str("component", "translation_memory").Logger()})
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, fmt.Errorf("error opening translation memory %#v: %w", path, err)
+	}
+	return &Memory{db: db}, nil
+}
+
+// Close closes the translation memory.
+func (m *Memory) Close() error {
+	return m.db.Close()
+}
+
+// value is the stored value of a translation.
+type value struct {
+	Text  string  `json:"text"`
+	Score float64 `json:"score"`
+}
+
+// Get returns the translation stored for the given key, reporting whether
+// it was found.
+func (m *Memory) Get(key models.MemoryKey) (models.Translation, bool, error) {
+	var v value
+	err := m.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(encodeKey(key))
+		if err != nil {
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			return json.Unmarshal(val, &v)
+		})
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return models.Translation{}, false, nil
+	}
+	if err != nil {
+		return models.Translation{}, false, fmt.Errorf("error reading from translation memory: %w", err)
+	}
+	return models.Translation{Text: v.Text, Score: v.Score}, true, nil
+}
+
+// Put stores the translation for the given key.
+func (m *Memory) Put(key models.MemoryKey, translation models.Translation) error {
+	val, err := json.Marshal(value{Text: translation.Text, Score: translation.Score})
+	if err != nil {
+		return err
+	}
+	err = m.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(encodeKey(key), val)
+	})
+	if err != nil {
+		return fmt.Errorf("error writing to translation memory: %w", err)
+	}
+	return nil
+}
+
+// Record is a single translation, as exported and imported by Export
+// and Import.
+type Record struct {
+	models.MemoryKey
+	Translation string  `json:"translation"`
+	Score       float64 `json:"score"`
+}
+
+// Export writes all the stored translations to w, as newline-delimited
+// JSON Record objects. It returns the number of exported records.
+func (m *Memory) Export(w io.Writer) (int, error) {
+	count := 0
+	enc := json.NewEncoder(w)
+	err := m.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			key, err := decodeKey(item.Key())
+			if err != nil {
+				return err
+			}
+			var v value
+			err = item.Value(func(val []byte) error {
+				return json.Unmarshal(val, &v)
+			})
+			if err != nil {
+				return err
+			}
+			err = enc.Encode(Record{MemoryKey: key, Translation: v.Text, Score: v.Score})
+			if err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return count, fmt.Errorf("error exporting translation memory: %w", err)
+	}
+	return count, nil
+}
+
+// Import reads newline-delimited JSON Record objects from r (such as the
+// ones written by Export) and stores them, replacing any existing
+// translation for the same keys. It returns the number of imported records.
+func (m *Memory) Import(r io.Reader) (int, error) {
+	wb := m.db.NewWriteBatch()
+	defer wb.Cancel()
+
+	count := 0
+	dec := json.NewDecoder(bufio.NewReader(r))
+	for {
+		var rec Record
+		err := dec.Decode(&rec)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("error decoding record %d: %w", count+1, err)
+		}
+		val, err := json.Marshal(value{Text: rec.Translation, Score: rec.Score})
+		if err != nil {
+			return 0, err
+		}
+		if err = wb.Set(encodeKey(rec.MemoryKey), val); err != nil {
+			return 0, fmt.Errorf("error importing record %d: %w", count+1, err)
+		}
+		count++
+	}
+
+	if err := wb.Flush(); err != nil {
+		return 0, fmt.Errorf("error importing translation memory: %w", err)
+	}
+	return count, nil
+}
+
+// keySeparator separates the fields of an encoded key. The segment is
+// the last field, so it can safely contain the separator.
+const keySeparator = "\x00"
+
+const numKeyFields = 6
+
+func encodeKey(key models.MemoryKey) []byte {
+	return []byte(strings.Join([]string{
+		key.Source,
+		key.Target,
+		key.Model,
+		key.ModelVersion,
+		key.Generation,
+		key.Segment,
+	}, keySeparator))
+}
+
+// decodeKey decodes a key encoded by encodeKey.
+//
+// Keys stored before the generation parameters were part of them have one
+// field less: they are decoded with an empty Generation, which never
+// matches the keys of new translations.
+func decodeKey(b []byte) (models.MemoryKey, error) {
+	fields := strings.SplitN(string(b), keySeparator, numKeyFields)
+	switch len(fields) {
+	case numKeyFields:
+		return models.MemoryKey{
+			Source:       fields[0],
+			Target:       fields[1],
+			Model:        fields[2],
+			ModelVersion: fields[3],
+			Generation:   fields[4],
+			Segment:      fields[5],
+		}, nil
+	case numKeyFields - 1:
+		return models.MemoryKey{
+			Source:       fields[0],
+			Target:       fields[1],
+			Model:        fields[2],
+			ModelVersion: fields[3],
+			Segment:      fields[4],
+		}, nil
+	default:
+		return models.MemoryKey{}, fmt.Errorf("invalid translation memory key %q", b)
+	}
+}
+
+// badgerLogger adapts a zerolog.Logger to badger.Logger.
+// Badger info messages are quite verbose, so they are logged at debug
+// level.
+type badgerLogger struct {
+	logger zerolog.Logger
+}
+
+func (l badgerLogger) Errorf(format string, v ...interface{}) {
+	l.logger.Error().Msgf(strings.TrimSpace(format), v...)
+}
+
+func (l badgerLogger) Warningf(format string, v ...interface{}) {
+	l.logger.Warn().Msgf(strings.TrimSpace(format), v...)
+}
+
+func (l badgerLogger) Infof(format string, v ...interface{}) {
+	l.logger.Debug().Msgf(strings.TrimSpace(format), v...)
+}
+
+func (l badgerLogger) Debugf(format string, v ...interface{}) {
+	l.logger.Trace().Msgf(strings.TrimSpace(format), v...)
+}
@@ -0,0 +1,128 @@
+// Copyright 2021 SpecializedGeneralist Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translationmemory_test
+
+import (
+	"bytes"
+	"github.com/SpecializedGeneralist/translator/pkg/models"
+	"github.com/SpecializedGeneralist/translator/pkg/translationmemory"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func openMemory(t *testing.T) *translationmemory.Memory {
+	t.Helper()
+	m, err := translationmemory.Open(t.TempDir(), zerolog.Nop())
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, m.Close()) })
+	return m
+}
+
+func TestMemory(t *testing.T) {
+	t.Parallel()
+
+	key := models.MemoryKey{
+		Source:       "it",
+		Target:       "en",
+		Model:        "Helsinki-NLP/opus-mt-it-en",
+		ModelVersion: "1",
+		Generation:   "{NumBeams:4}",
+		Segment:      "Buongiorno a tutti.",
+	}
+	translation := models.Translation{Text: "Good morning everyone.", Score: -0.25}
+
+	t.Run("get and put", func(t *testing.T) {
+		t.Parallel()
+		m := openMemory(t)
+
+		_, found, err := m.Get(key)
+		require.NoError(t, err)
+		assert.False(t, found)
+
+		require.NoError(t, m.Put(key, translation))
+
+		actual, found, err := m.Get(key)
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, translation, actual)
+
+		otherVersion := key
+		otherVersion.ModelVersion = "2"
+		_, found, err = m.Get(otherVersion)
+		require.NoError(t, err)
+		assert.False(t, found)
+
+		otherGeneration := key
+		otherGeneration.Generation = "{NumBeams:1}"
+		_, found, err = m.Get(otherGeneration)
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("export and import", func(t *testing.T) {
+		t.Parallel()
+		src := openMemory(t)
+		require.NoError(t, src.Put(key, translation))
+
+		var buf bytes.Buffer
+		count, err := src.Export(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		dst := openMemory(t)
+		count, err = dst.Import(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		actual, found, err := dst.Get(key)
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, translation, actual)
+	})
+	t.Run("export keys without generation parameters", func(t *testing.T) {
+		t.Parallel()
+		path := t.TempDir()
+
+		// Keys stored before the generation parameters were part of them.
+		db, err := badger.Open(badger.DefaultOptions(path).WithLogger(nil))
+		require.NoError(t, err)
+		require.NoError(t, db.Update(func(txn *badger.Txn) error {
+			return txn.Set([]byte("it\x00en\x00m\x001\x00Ciao."), []byte(`{"text":"Hi.","score":-1}`))
+		}))
+		require.NoError(t, db.Close())
+
+		m, err := translationmemory.Open(path, zerolog.Nop())
+		require.NoError(t, err)
+		defer m.Close()
+
+		var buf bytes.Buffer
+		count, err := m.Export(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.JSONEq(t, `{
+			"source": "it",
+			"target": "en",
+			"model": "m",
+			"model_version": "1",
+			"generation": "",
+			"segment": "Ciao.",
+			"translation": "Hi.",
+			"score": -1
+		}`, buf.String())
+	})
+}